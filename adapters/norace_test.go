@@ -0,0 +1,6 @@
+//go:build !race
+
+package adapters
+
+// raceEnabled indica se os testes foram compilados com o race detector
+const raceEnabled = false
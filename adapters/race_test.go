@@ -0,0 +1,8 @@
+//go:build race
+
+package adapters
+
+// raceEnabled indica se os testes foram compilados com o race detector,
+// que descarta itens de sync.Pool aleatoriamente e invalida as contagens
+// de alocação
+const raceEnabled = true
@@ -0,0 +1,187 @@
+package adapters
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/victorximenis/logger/core"
+)
+
+// SlogHandler implementa slog.Handler encaminhando os registros para um
+// core.LoggerAdapter. Com o adapter do logger global, as entradas de
+// bibliotecas que usam slog passam pelo mesmo formatter, sanitização, hooks
+// e enriquecimento de observabilidade das demais entradas.
+type SlogHandler struct {
+	// adapter retorna o adapter de destino, resolvido a cada registro
+	adapter func() core.LoggerAdapter
+	// fields contém os atributos adicionados via WithAttrs, já aninhados
+	// nos grupos abertos no momento da chamada. Nunca é modificado.
+	fields map[string]interface{}
+	// groups são os grupos abertos via WithGroup, do mais externo ao mais interno
+	groups []string
+}
+
+// NewSlogHandler cria um slog.Handler que escreve no adapter especificado.
+// Para escrever no logger global, que é substituído a cada logger.Init, use
+// logger.NewGlobalSlogHandler.
+func NewSlogHandler(adapter core.LoggerAdapter) *SlogHandler {
+	return &SlogHandler{adapter: func() core.LoggerAdapter { return adapter }}
+}
+
+// NewSlogHandlerFunc cria um slog.Handler que obtém o adapter de destino
+// via resolve a cada registro
+func NewSlogHandlerFunc(resolve func() core.LoggerAdapter) *SlogHandler {
+	return &SlogHandler{adapter: resolve}
+}
+
+// Enabled implementa a interface slog.Handler. O override de nível do
+// componente é considerado quando o campo "component" foi adicionado via
+// WithAttrs.
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return core.IsComponentLevelEnabled(h.adapter(), core.ComponentFromFields(h.fields), slogLevel(level))
+}
+
+// Handle implementa a interface slog.Handler
+func (h *SlogHandler) Handle(ctx context.Context, record slog.Record) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	fields := copySlogFields(h.fields)
+	if record.NumAttrs() > 0 {
+		target := openSlogGroups(fields, h.groups)
+		record.Attrs(func(attr slog.Attr) bool {
+			addSlogAttr(target, attr)
+			return true
+		})
+	}
+
+	h.adapter().Log(ctx, slogLevel(record.Level), record.Message, fields)
+	return nil
+}
+
+// WithAttrs implementa a interface slog.Handler
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	fields := copySlogFields(h.fields)
+	target := openSlogGroups(fields, h.groups)
+	for _, attr := range attrs {
+		addSlogAttr(target, attr)
+	}
+	return &SlogHandler{adapter: h.adapter, fields: fields, groups: h.groups}
+}
+
+// WithGroup implementa a interface slog.Handler
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	groups := make([]string, len(h.groups), len(h.groups)+1)
+	copy(groups, h.groups)
+	return &SlogHandler{adapter: h.adapter, fields: h.fields, groups: append(groups, name)}
+}
+
+// slogLevel converte um nível do slog, que usa a mesma escala de
+// severidade do core, para core.Level. Níveis customizados registrados são
+// mantidos, os demais são mapeados para o nível padrão imediatamente
+// inferior e níveis acima de ERROR são limitados a ERROR, pois FATAL e
+// PANIC têm efeitos que o slog não prevê.
+func slogLevel(level slog.Level) core.Level {
+	l := core.Level(level)
+	if l > core.ERROR {
+		return core.ERROR
+	}
+	if l.IsValid() {
+		return l
+	}
+	return l.BuiltinLevel()
+}
+
+// openSlogGroups retorna o map do grupo mais interno, criando os maps
+// intermediários quando necessário
+func openSlogGroups(fields map[string]interface{}, groups []string) map[string]interface{} {
+	for _, name := range groups {
+		group, ok := fields[name].(map[string]interface{})
+		if !ok {
+			group = make(map[string]interface{})
+			fields[name] = group
+		}
+		fields = group
+	}
+	return fields
+}
+
+// addSlogAttr adiciona o atributo aos campos, seguindo as regras do slog:
+// atributos vazios são ignorados, grupos sem chave são incorporados ao
+// nível atual e grupos sem atributos são omitidos
+func addSlogAttr(fields map[string]interface{}, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+
+	if attr.Value.Kind() != slog.KindGroup {
+		fields[attr.Key] = slogValue(attr.Value)
+		return
+	}
+
+	attrs := attr.Value.Group()
+	if len(attrs) == 0 {
+		return
+	}
+	if attr.Key == "" {
+		for _, a := range attrs {
+			addSlogAttr(fields, a)
+		}
+		return
+	}
+
+	group, ok := fields[attr.Key].(map[string]interface{})
+	if !ok {
+		group = make(map[string]interface{}, len(attrs))
+		fields[attr.Key] = group
+	}
+	for _, a := range attrs {
+		addSlogAttr(group, a)
+	}
+}
+
+// slogValue converte um slog.Value resolvido para o valor nativo
+// correspondente, preservando tipos como time.Duration, time.Time e error
+func slogValue(value slog.Value) interface{} {
+	switch value.Kind() {
+	case slog.KindString:
+		return value.String()
+	case slog.KindInt64:
+		return value.Int64()
+	case slog.KindUint64:
+		return value.Uint64()
+	case slog.KindFloat64:
+		return value.Float64()
+	case slog.KindBool:
+		return value.Bool()
+	case slog.KindDuration:
+		return value.Duration()
+	case slog.KindTime:
+		return value.Time()
+	default:
+		return value.Any()
+	}
+}
+
+// copySlogFields retorna uma cópia profunda dos campos, copiando os maps
+// dos grupos para que possam receber novos atributos
+func copySlogFields(fields map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(fields))
+	for k, v := range fields {
+		if group, ok := v.(map[string]interface{}); ok {
+			v = copySlogFields(group)
+		}
+		copied[k] = v
+	}
+	return copied
+}
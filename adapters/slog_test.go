@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/victorximenis/logger/core"
+)
+
+func TestSlogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Level:  core.INFO,
+		FormatterConfig: &core.Config{
+			ServiceName:           "test-service",
+			Environment:           "test",
+			SanitizeSensitiveData: true,
+		},
+	})
+	log := slog.New(NewSlogHandler(adapter))
+	ctx := core.WithRequestID(context.Background(), "req-1")
+
+	log.DebugContext(ctx, "disabled")
+	log.With("lib", "pgx").
+		WithGroup("http").
+		With("method", "GET").
+		WarnContext(ctx, "slow request",
+			slog.Int("status", 200),
+			slog.Group("client", slog.String("ip", "10.0.0.1")),
+			slog.Group("empty"),
+			slog.String("password", "secret123"),
+		)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("Expected 1 entry, got %d: %s", len(lines), buf.String())
+	}
+	if strings.Contains(lines[0], "secret123") {
+		t.Errorf("Expected sanitization to apply to slog attrs, got %s", lines[0])
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("Failed to parse entry: %v", err)
+	}
+	if entry["level"] != "WARN" || entry["message"] != "slow request" {
+		t.Errorf("Expected level and message to be mapped, got %v", entry)
+	}
+	if entry["lib"] != "pgx" || entry["request_id"] != "req-1" || entry["service"] != "test-service" {
+		t.Errorf("Expected handler attrs and formatter fields, got %v", entry)
+	}
+
+	http, _ := entry["http"].(map[string]interface{})
+	client, _ := http["client"].(map[string]interface{})
+	if http["method"] != "GET" || http["status"] != float64(200) || client["ip"] != "10.0.0.1" {
+		t.Errorf("Expected attrs nested under group 'http', got %v", entry["http"])
+	}
+	if _, ok := http["empty"]; ok {
+		t.Error("Expected empty groups to be omitted")
+	}
+}
+
+func TestSlogLevel(t *testing.T) {
+	// Níveis registrados são globais ao processo; a severidade 1 não é
+	// registrada por nenhum teste e NOTICE é registrado aqui de forma idempotente
+	notice := core.MustRegisterLevel("NOTICE", 2)
+
+	tests := []struct {
+		level    slog.Level
+		expected core.Level
+	}{
+		{slog.LevelDebug, core.DEBUG},
+		{slog.LevelInfo, core.INFO},
+		{slog.LevelInfo + 1, core.INFO},
+		{slog.LevelInfo + 2, notice},
+		{slog.LevelWarn, core.WARN},
+		{slog.LevelError, core.ERROR},
+		{slog.LevelError + 8, core.ERROR},
+		{slog.LevelDebug - 8, core.TRACE},
+	}
+
+	for _, tt := range tests {
+		if got := slogLevel(tt.level); got != tt.expected {
+			t.Errorf("slogLevel(%v) = %v, expected %v", tt.level, got, tt.expected)
+		}
+	}
+}
@@ -2,17 +2,42 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"net"
 	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/victorximenis/logger/core"
+	"github.com/victorximenis/logger/sanitize"
 )
 
 // ZerologAdapter implementa a interface LoggerAdapter usando a biblioteca zerolog
 type ZerologAdapter struct {
 	logger    zerolog.Logger
 	formatter *core.Formatter
+	// level controla o nível mínimo em tempo de execução. Quando nil,
+	// o nível configurado no zerolog.Logger é utilizado.
+	level *core.AtomicLevel
+	// levels contém os overrides de nível por componente (opcional)
+	levels *core.LevelRegistry
+	// writer é o destino configurado, descarregado por Flush
+	writer io.Writer
+	// closer é o recurso liberado por Close (opcional)
+	closer io.Closer
+	// hooks são executados sobre cada entrada após a formatação. Nunca é
+	// modificado após a criação do adapter.
+	hooks []core.Hook
+	// sanitizer mascara dados sensíveis após os hooks, quando
+	// FormatterConfig.SanitizeSensitiveData está habilitado
+	sanitizer core.Hook
+	// callerEnabled adiciona o caller às entradas que não o contêm
+	callerEnabled bool
 }
 
 // ZerologConfig define as opções de configuração para o ZerologAdapter
@@ -21,14 +46,31 @@ type ZerologConfig struct {
 	Writer io.Writer
 	// Level define o nível mínimo de log (padrão: INFO)
 	Level core.Level
+	// AtomicLevel permite alterar o nível mínimo em tempo de execução.
+	// Quando definido, tem precedência sobre Level.
+	AtomicLevel *core.AtomicLevel
+	// Levels habilita overrides de nível por componente, resolvidos pelo campo
+	// "component" de cada entrada. Quando definido, seu nível global tem
+	// precedência sobre AtomicLevel e Level.
+	Levels *core.LevelRegistry
 	// TimeFormat define o formato do timestamp (padrão: RFC3339)
 	TimeFormat string
 	// PrettyPrint habilita formatação legível para desenvolvimento (padrão: false)
 	PrettyPrint bool
-	// CallerEnabled habilita informações do caller nos logs (padrão: false)
+	// CallerEnabled adiciona os campos "caller" e "function" às entradas que
+	// ainda não os contêm, apontando para o primeiro frame fora dos pacotes da
+	// biblioteca. Destina-se a chamadas diretas a Log; entradas criadas via
+	// LogEvent devem usar core.EventOptions.CallerEnabled (Config.CallerEnabled
+	// no logger global), que captura o caller no momento de Msg/Send.
 	CallerEnabled bool
 	// FormatterConfig define a configuração para o formatter JSON
 	FormatterConfig *core.Config
+	// Closer é o recurso liberado por Close, como o core.OutputManager que
+	// fornece o Writer (opcional). O Writer em si nunca é fechado pelo adapter.
+	Closer io.Closer
+	// Hooks são executados em ordem sobre cada entrada após a formatação,
+	// recebendo os campos base e os do contexto, e antes da escrita
+	Hooks []core.Hook
 }
 
 // NewZerologAdapter cria uma nova instância do ZerologAdapter com a configuração especificada.
@@ -56,13 +98,18 @@ func NewZerologAdapter(config *ZerologConfig) *ZerologAdapter {
 		writer = os.Stdout
 	}
 
-	// Configurar pretty print para desenvolvimento
+	// Configurar pretty print para desenvolvimento. Writers que tratam o
+	// nível das entradas recebem o nível de cada entrada do zerolog.
+	var output io.Writer = writer
+	if lw, ok := writer.(core.LevelWriter); ok {
+		output = zerologLevelWriter{lw}
+	}
 	if config.PrettyPrint {
-		writer = zerolog.ConsoleWriter{Out: writer}
+		output = zerolog.ConsoleWriter{Out: writer}
 	}
 
 	// Criar logger base
-	logger := zerolog.New(writer)
+	logger := zerolog.New(output)
 
 	// Configurar timestamp
 	if config.TimeFormat != "" {
@@ -70,18 +117,28 @@ func NewZerologAdapter(config *ZerologConfig) *ZerologAdapter {
 		logger = logger.With().Timestamp().Logger()
 	}
 
-	// Configurar caller se habilitado
-	if config.CallerEnabled {
-		logger = logger.With().Caller().Logger()
+	// Configurar nível de log. A filtragem é feita pelo AtomicLevel, então o
+	// zerolog aceita todos os níveis para que alterações tenham efeito imediato.
+	level := config.AtomicLevel
+	if config.Levels != nil {
+		level = config.Levels.Global()
 	}
+	if level == nil {
+		level = core.NewAtomicLevel(config.Level)
+	}
+	logger = logger.Level(zerolog.TraceLevel)
 
-	// Configurar nível de log
-	logger = logger.Level(mapLevelToZerolog(config.Level))
-
-	// Criar formatter
+	// Criar formatter. A sanitização é aplicada como o último hook, para
+	// alcançar também os campos adicionados pelos demais hooks.
 	var formatter *core.Formatter
+	var sanitizer core.Hook
 	if config.FormatterConfig != nil {
-		formatter = core.NewFormatter(*config.FormatterConfig)
+		formatterConfig := *config.FormatterConfig
+		if formatterConfig.SanitizeSensitiveData {
+			formatterConfig.SanitizeSensitiveData = false
+			sanitizer = core.NewSanitizeHook(sanitize.DefaultSensitiveFieldConfig())
+		}
+		formatter = core.NewFormatter(formatterConfig)
 	} else {
 		formatter = core.NewFormatter(core.Config{
 			ServiceName:           "unknown-service",
@@ -92,8 +149,15 @@ func NewZerologAdapter(config *ZerologConfig) *ZerologAdapter {
 	}
 
 	return &ZerologAdapter{
-		logger:    logger,
-		formatter: formatter,
+		logger:        logger,
+		formatter:     formatter,
+		level:         level,
+		levels:        config.Levels,
+		writer:        writer,
+		closer:        config.Closer,
+		hooks:         config.Hooks,
+		sanitizer:     sanitizer,
+		callerEnabled: config.CallerEnabled,
 	}
 }
 
@@ -123,18 +187,176 @@ func NewZerologAdapterFromLoggerWithFormatter(logger zerolog.Logger, formatter *
 	}
 }
 
+// baseFieldsPool reutiliza os buffers de campos base usados por LogTyped
+var baseFieldsPool = sync.Pool{
+	New: func() interface{} {
+		fields := make([]core.Field, 0, 8)
+		return &fields
+	},
+}
+
 // Log implementa o método Log da interface LoggerAdapter
 func (z *ZerologAdapter) Log(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
-	if !z.IsLevelEnabled(level) {
+	if !z.IsComponentLevelEnabled(core.ComponentFromFields(fields), level) {
 		return
 	}
 
-	// Usar formatter para padronizar os campos do log
+	// Usar formatter para padronizar os campos do log. O map resultante é
+	// uma cópia e pode ser modificado pelos hooks.
 	formattedFields := z.formatter.FormatLogEvent(ctx, level, msg, fields)
+	if z.callerEnabled {
+		if _, exists := formattedFields[core.CallerKey]; !exists {
+			if caller, function, ok := captureCaller(); ok {
+				formattedFields[core.CallerKey] = caller
+				formattedFields[core.FunctionKey] = function
+			}
+		}
+	}
+	if z.hasHooks() {
+		delete(formattedFields, "message")
+		entry := core.Entry{Context: ctx, Level: level, Message: msg, Fields: formattedFields}
+		if !z.runHooks(&entry) {
+			return
+		}
+		ctx, level, msg, formattedFields = entry.Context, entry.Level, entry.Message, entry.Fields
+	}
 
 	// Criar evento de log com o nível apropriado
+	event := z.newEvent(ctx, level)
+
+	// Adicionar todos os campos formatados
+	for key, value := range formattedFields {
+		if key != "message" { // Mensagem é tratada separadamente
+			event = addFieldToEvent(event, key, value)
+		}
+	}
+
+	// Enviar mensagem
+	event.Msg(msg)
+}
+
+// LogTyped implementa a interface core.TypedLoggerAdapter, escrevendo os
+// campos tipados diretamente nos encoders nativos do zerolog
+func (z *ZerologAdapter) LogTyped(ctx context.Context, level core.Level, msg string, fields []core.Field) {
+	if !z.IsComponentLevelEnabled(core.ComponentFromTypedFields(fields), level) {
+		return
+	}
+
+	// Hooks e sanitização operam sobre a representação em map
+	if z.hasHooks() || z.formatter.RequiresMap() {
+		z.Log(ctx, level, msg, core.FieldsToMap(fields))
+		return
+	}
+
+	event := z.newEvent(ctx, level)
+
+	if z.callerEnabled && !core.HasField(fields, core.CallerKey) {
+		if caller, function, ok := captureCaller(); ok {
+			event = event.Str(core.CallerKey, caller).Str(core.FunctionKey, function)
+		}
+	}
+
+	// Adicionar campos base que não foram sobrescritos pelo evento
+	buf := baseFieldsPool.Get().(*[]core.Field)
+	base := z.formatter.AppendBaseFields((*buf)[:0], ctx, level)
+	for i := range base {
+		if !core.HasField(fields, base[i].Key) {
+			event = appendTypedField(event, base[i])
+		}
+	}
+	clear(base)
+	*buf = base[:0]
+	baseFieldsPool.Put(buf)
+
+	// Adicionar campos do evento
+	for i := range fields {
+		if fields[i].Key != "message" { // Mensagem é tratada separadamente
+			event = appendTypedField(event, fields[i])
+		}
+	}
+
+	// Enviar mensagem
+	event.Msg(msg)
+}
+
+// hasHooks informa se há hooks ou sanitização a aplicar após a formatação
+func (z *ZerologAdapter) hasHooks() bool {
+	return len(z.hooks) > 0 || z.sanitizer != nil
+}
+
+// runHooks executa os hooks e a sanitização sobre a entrada formatada.
+// Retorna false se algum hook descartar a entrada.
+func (z *ZerologAdapter) runHooks(entry *core.Entry) bool {
+	level := entry.Level
+	if !core.RunHooks(entry, z.hooks) {
+		return false
+	}
+	// Manter o campo "level" do formatter coerente com o nível alterado
+	if entry.Level != level {
+		if _, ok := entry.Fields["level"]; ok {
+			entry.Fields["level"] = entry.Level.String()
+		}
+	}
+	if z.sanitizer != nil {
+		return z.sanitizer.Process(entry)
+	}
+	return true
+}
+
+// WithHooks implementa a interface core.HookableAdapter, retornando uma
+// cópia do adapter que executa os hooks especificados após os existentes
+func (z *ZerologAdapter) WithHooks(hooks ...core.Hook) core.LoggerAdapter {
+	combined := make([]core.Hook, 0, len(z.hooks)+len(hooks))
+	combined = append(combined, z.hooks...)
+	combined = append(combined, hooks...)
+
+	copied := *z
+	copied.hooks = combined
+	return &copied
+}
+
+// callerSkipPackages são os pacotes da biblioteca ignorados na captura do
+// caller por CallerEnabled
+var callerSkipPackages = map[string]bool{
+	"github.com/victorximenis/logger":               true,
+	"github.com/victorximenis/logger/core":          true,
+	"github.com/victorximenis/logger/adapters":      true,
+	"github.com/victorximenis/logger/observability": true,
+}
+
+// captureCaller retorna o arquivo:linha e a função do primeiro frame fora
+// dos pacotes da biblioteca. Frames de arquivos de teste nunca são ignorados.
+func captureCaller() (caller, function string, ok bool) {
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !callerSkipPackages[framePackage(frame.Function)] || strings.HasSuffix(frame.File, "_test.go") {
+			return frame.File + ":" + strconv.Itoa(frame.Line), frame.Function, true
+		}
+		if !more {
+			return "", "", false
+		}
+	}
+}
+
+// framePackage retorna o caminho do pacote de uma função no formato
+// retornado por runtime.Frame.Function (ex: "github.com/a/b.(*T).Method")
+func framePackage(function string) string {
+	slash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[slash+1:], "."); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+	return function
+}
+
+// newEvent cria um evento zerolog com o nível apropriado e o contexto associado
+func (z *ZerologAdapter) newEvent(ctx context.Context, level core.Level) *zerolog.Event {
 	var event *zerolog.Event
 	switch level {
+	case core.TRACE:
+		event = z.logger.Trace()
 	case core.DEBUG:
 		event = z.logger.Debug()
 	case core.INFO:
@@ -144,9 +366,14 @@ func (z *ZerologAdapter) Log(ctx context.Context, level core.Level, msg string,
 	case core.ERROR:
 		event = z.logger.Error()
 	case core.FATAL:
-		event = z.logger.Fatal()
+		// O encerramento do processo é feito pelo LogEvent via core.Exit,
+		// após o registro da entrada e a execução dos shutdown hooks
+		event = z.logger.WithLevel(zerolog.FatalLevel)
+	case core.PANIC:
+		// O panic é disparado pelo LogEvent após o envio da entrada
+		event = z.logger.WithLevel(zerolog.PanicLevel)
 	default:
-		event = z.logger.Info()
+		event = z.logger.WithLevel(mapLevelToZerolog(level))
 	}
 
 	// Adicionar contexto se disponível
@@ -154,15 +381,7 @@ func (z *ZerologAdapter) Log(ctx context.Context, level core.Level, msg string,
 		event = event.Ctx(ctx)
 	}
 
-	// Adicionar todos os campos formatados
-	for key, value := range formattedFields {
-		if key != "message" { // Mensagem é tratada separadamente
-			event = addFieldToEvent(event, key, value)
-		}
-	}
-
-	// Enviar mensagem
-	event.Msg(msg)
+	return event
 }
 
 // WithContext implementa o método WithContext da interface LoggerAdapter
@@ -176,20 +395,74 @@ func (z *ZerologAdapter) WithContext(ctx context.Context) core.LoggerAdapter {
 	}
 
 	return &ZerologAdapter{
-		logger:    newLogger,
-		formatter: z.formatter, // Preservar o formatter
+		logger:        newLogger,
+		formatter:     z.formatter, // Preservar o formatter
+		level:         z.level,     // Compartilhar o nível ajustável
+		levels:        z.levels,
+		writer:        z.writer,
+		closer:        z.closer,
+		hooks:         z.hooks,
+		sanitizer:     z.sanitizer,
+		callerEnabled: z.callerEnabled,
+	}
+}
+
+// Flush implementa a interface core.Flusher, descarregando o writer
+// configurado quando ele mantém entradas em buffer
+func (z *ZerologAdapter) Flush(ctx context.Context) error {
+	return core.FlushWriter(ctx, z.writer)
+}
+
+// Close implementa a interface io.Closer, liberando o recurso configurado
+// em ZerologConfig.Closer
+func (z *ZerologAdapter) Close() error {
+	if z.closer == nil {
+		return nil
+	}
+	return z.closer.Close()
+}
+
+// IsComponentLevelEnabled implementa a interface core.ComponentLevelEnabler
+func (z *ZerologAdapter) IsComponentLevelEnabled(component string, level core.Level) bool {
+	if z.levels != nil {
+		return z.levels.Enabled(component, level)
 	}
+	return z.IsLevelEnabled(level)
+}
+
+// Levels retorna o LevelRegistry usado pelo adapter, ou nil quando não há
+// overrides de nível por componente
+func (z *ZerologAdapter) Levels() *core.LevelRegistry {
+	return z.levels
+}
+
+// Level retorna o AtomicLevel usado pelo adapter, ou nil quando o nível
+// é controlado pelo zerolog.Logger informado em NewZerologAdapterFromLogger
+func (z *ZerologAdapter) Level() *core.AtomicLevel {
+	return z.level
 }
 
-// IsLevelEnabled implementa o método IsLevelEnabled da interface LoggerAdapter
+// IsLevelEnabled implementa o método IsLevelEnabled da interface
+// LoggerAdapter, usando o nível global. Os overrides por componente são
+// considerados por IsComponentLevelEnabled.
 func (z *ZerologAdapter) IsLevelEnabled(level core.Level) bool {
+	if z.levels != nil {
+		return z.levels.Enabled("", level)
+	}
+	if z.level != nil {
+		return z.level.Enabled(level)
+	}
 	zerologLevel := mapLevelToZerolog(level)
 	return z.logger.GetLevel() <= zerologLevel
 }
 
-// mapLevelToZerolog mapeia os níveis customizados para os níveis do zerolog
+// mapLevelToZerolog mapeia os níveis customizados para os níveis do zerolog.
+// Níveis registrados via core.RegisterLevel usam o nível padrão mais severo
+// que não excede sua severidade.
 func mapLevelToZerolog(level core.Level) zerolog.Level {
 	switch level {
+	case core.TRACE:
+		return zerolog.TraceLevel
 	case core.DEBUG:
 		return zerolog.DebugLevel
 	case core.INFO:
@@ -200,11 +473,48 @@ func mapLevelToZerolog(level core.Level) zerolog.Level {
 		return zerolog.ErrorLevel
 	case core.FATAL:
 		return zerolog.FatalLevel
+	case core.PANIC:
+		return zerolog.PanicLevel
 	default:
+		if level.IsValid() {
+			return mapLevelToZerolog(level.BuiltinLevel())
+		}
 		return zerolog.InfoLevel
 	}
 }
 
+// mapLevelFromZerolog mapeia os níveis do zerolog para os níveis do core.
+// Entradas sem nível são tratadas como INFO.
+func mapLevelFromZerolog(level zerolog.Level) core.Level {
+	switch level {
+	case zerolog.TraceLevel:
+		return core.TRACE
+	case zerolog.DebugLevel:
+		return core.DEBUG
+	case zerolog.WarnLevel:
+		return core.WARN
+	case zerolog.ErrorLevel:
+		return core.ERROR
+	case zerolog.FatalLevel:
+		return core.FATAL
+	case zerolog.PanicLevel:
+		return core.PANIC
+	default:
+		return core.INFO
+	}
+}
+
+// zerologLevelWriter encaminha o nível das entradas do zerolog para um
+// core.LevelWriter, como o core.AsyncWriter
+type zerologLevelWriter struct {
+	core.LevelWriter
+}
+
+// WriteLevel implementa a interface zerolog.LevelWriter
+func (w zerologLevelWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	return w.LevelWriter.WriteLevel(mapLevelFromZerolog(level), p)
+}
+
 // addFieldToEvent adiciona um campo ao evento zerolog, tratando tipos especiais
 func addFieldToEvent(event *zerolog.Event, key string, value interface{}) *zerolog.Event {
 	switch v := value.(type) {
@@ -222,9 +532,166 @@ func addFieldToEvent(event *zerolog.Event, key string, value interface{}) *zerol
 		return event.Float64(key, v)
 	case bool:
 		return event.Bool(key, v)
+	case uint64:
+		return event.Uint64(key, v)
+	case time.Duration:
+		return event.Dur(key, v)
+	case time.Time:
+		return event.Time(key, v)
+	case []string:
+		return event.Strs(key, v)
+	case []int:
+		return event.Ints(key, v)
+	case []byte:
+		return event.Bytes(key, v)
+	case net.IP:
+		return event.IPAddr(key, v)
 	case error:
 		return event.AnErr(key, v)
+	case core.Lazy:
+		return appendTypedField(event, core.Any(key, v.Resolve()))
+	case map[string]interface{}:
+		dict := zerolog.Dict()
+		for k, val := range v {
+			dict = addFieldToEvent(dict, k, val)
+		}
+		return event.Dict(key, dict)
+	case []interface{}:
+		arr := zerolog.Arr()
+		for _, val := range v {
+			arr = addValueToArray(arr, val)
+		}
+		return event.Array(key, arr)
 	default:
 		return event.Interface(key, v)
 	}
 }
+
+// addValueToArray adiciona um elemento ao array zerolog baseado no seu tipo
+func addValueToArray(arr *zerolog.Array, value interface{}) *zerolog.Array {
+	switch v := value.(type) {
+	case string:
+		return arr.Str(v)
+	case int:
+		return arr.Int(v)
+	case int32:
+		return arr.Int32(v)
+	case int64:
+		return arr.Int64(v)
+	case uint64:
+		return arr.Uint64(v)
+	case float32:
+		return arr.Float32(v)
+	case float64:
+		return arr.Float64(v)
+	case bool:
+		return arr.Bool(v)
+	case time.Duration:
+		return arr.Dur(v)
+	case time.Time:
+		return arr.Time(v)
+	case []byte:
+		return arr.Bytes(v)
+	case net.IP:
+		return arr.IPAddr(v)
+	case error:
+		return arr.Err(v)
+	case core.Lazy:
+		return appendTypedElement(arr, core.Any("", v.Resolve()))
+	case map[string]interface{}:
+		dict := zerolog.Dict()
+		for k, val := range v {
+			dict = addFieldToEvent(dict, k, val)
+		}
+		return arr.Dict(dict)
+	default:
+		return arr.Interface(v)
+	}
+}
+
+// appendTypedField adiciona um campo tipado ao evento zerolog usando o encoder nativo
+func appendTypedField(event *zerolog.Event, field core.Field) *zerolog.Event {
+	switch field.Type {
+	case core.StringType:
+		return event.Str(field.Key, field.String)
+	case core.IntType:
+		return event.Int(field.Key, int(field.Integer))
+	case core.Int64Type:
+		return event.Int64(field.Key, field.Integer)
+	case core.Float64Type:
+		return event.Float64(field.Key, field.Float)
+	case core.BoolType:
+		return event.Bool(field.Key, field.Integer == 1)
+	case core.Uint64Type:
+		return event.Uint64(field.Key, uint64(field.Integer))
+	case core.DurationType:
+		return event.Dur(field.Key, time.Duration(field.Integer))
+	case core.TimeType:
+		return event.Time(field.Key, field.TimeValue())
+	case core.StringsType:
+		vals, _ := field.Interface.([]string)
+		return event.Strs(field.Key, vals)
+	case core.IntsType:
+		vals, _ := field.Interface.([]int)
+		return event.Ints(field.Key, vals)
+	case core.BytesType:
+		val, _ := field.Interface.([]byte)
+		return event.Bytes(field.Key, val)
+	case core.HexType:
+		val, _ := field.Interface.([]byte)
+		return event.Hex(field.Key, val)
+	case core.IPAddrType:
+		ip, _ := field.Interface.(net.IP)
+		return event.IPAddr(field.Key, ip)
+	case core.StringerType:
+		val, _ := field.Interface.(fmt.Stringer)
+		return event.Stringer(field.Key, val)
+	case core.DictType:
+		fields, _ := field.Interface.([]core.Field)
+		dict := zerolog.Dict()
+		for i := range fields {
+			dict = appendTypedField(dict, fields[i])
+		}
+		return event.Dict(field.Key, dict)
+	case core.ArrayType:
+		fields, _ := field.Interface.([]core.Field)
+		arr := zerolog.Arr()
+		for i := range fields {
+			arr = appendTypedElement(arr, fields[i])
+		}
+		return event.Array(field.Key, arr)
+	default:
+		return addFieldToEvent(event, field.Key, field.Interface)
+	}
+}
+
+// appendTypedElement adiciona um elemento tipado ao array zerolog usando o encoder nativo
+func appendTypedElement(arr *zerolog.Array, field core.Field) *zerolog.Array {
+	switch field.Type {
+	case core.StringType:
+		return arr.Str(field.String)
+	case core.IntType:
+		return arr.Int(int(field.Integer))
+	case core.Int64Type:
+		return arr.Int64(field.Integer)
+	case core.Uint64Type:
+		return arr.Uint64(uint64(field.Integer))
+	case core.Float64Type:
+		return arr.Float64(field.Float)
+	case core.BoolType:
+		return arr.Bool(field.Integer == 1)
+	case core.DurationType:
+		return arr.Dur(time.Duration(field.Integer))
+	case core.TimeType:
+		return arr.Time(field.TimeValue())
+	case core.DictType:
+		fields, _ := field.Interface.([]core.Field)
+		dict := zerolog.Dict()
+		for i := range fields {
+			dict = appendTypedField(dict, fields[i])
+		}
+		return arr.Dict(dict)
+	default:
+		return addValueToArray(arr, field.Value())
+	}
+}
@@ -5,11 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"net"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/victorximenis/logger/core"
+	"github.com/victorximenis/logger/observability"
 )
 
 func TestNewZerologAdapter(t *testing.T) {
@@ -258,12 +263,15 @@ func TestMapLevelToZerolog(t *testing.T) {
 		input    core.Level
 		expected zerolog.Level
 	}{
+		{core.TRACE, zerolog.TraceLevel},
 		{core.DEBUG, zerolog.DebugLevel},
 		{core.INFO, zerolog.InfoLevel},
 		{core.WARN, zerolog.WarnLevel},
 		{core.ERROR, zerolog.ErrorLevel},
 		{core.FATAL, zerolog.FatalLevel},
-		{core.Level(999), zerolog.InfoLevel}, // Unknown level defaults to INFO
+		{core.PANIC, zerolog.PanicLevel},
+		{core.MustRegisterLevel("AUDIT", 10), zerolog.ErrorLevel}, // Custom level maps to nearest builtin below
+		{core.Level(999), zerolog.InfoLevel},                      // Unknown level defaults to INFO
 	}
 
 	for _, tt := range tests {
@@ -359,3 +367,650 @@ func TestZerologAdapter_Integration(t *testing.T) {
 		}
 	}
 }
+
+func TestZerologAdapter_LogTyped(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Level:  core.DEBUG,
+		FormatterConfig: &core.Config{
+			ServiceName: "test-service",
+			Environment: "test",
+		},
+	})
+
+	var _ core.TypedLoggerAdapter = adapter
+
+	ctx := core.WithTraceID(context.Background(), "trace-123")
+	adapter.LogTyped(ctx, core.WARN, "typed message", []core.Field{
+		core.String("user_id", "123"),
+		core.Int("attempt", 2),
+		core.Int64("bytes", 2048),
+		core.Float64("ratio", 0.5),
+		core.Bool("success", false),
+		core.String("service", "override"),
+	})
+
+	var logEntry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &logEntry); err != nil {
+		t.Fatalf("Failed to parse log output as JSON: %v\nOutput: %s", err, buf.String())
+	}
+
+	expected := map[string]interface{}{
+		"level":    "WARN",
+		"message":  "typed message",
+		"env":      "test",
+		"trace_id": "trace-123",
+		"user_id":  "123",
+		"attempt":  float64(2),
+		"bytes":    float64(2048),
+		"ratio":    0.5,
+		"success":  false,
+		"service":  "override",
+	}
+	for key, expectedValue := range expected {
+		if logEntry[key] != expectedValue {
+			t.Errorf("Field %s: expected %v, got %v", key, expectedValue, logEntry[key])
+		}
+	}
+
+	// Campos sobrescritos pelo evento não devem ser duplicados
+	if count := strings.Count(buf.String(), `"service"`); count != 1 {
+		t.Errorf("Expected service key once, found %d times in %s", count, buf.String())
+	}
+}
+
+func TestZerologAdapter_LogTypedWithSanitization(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Level:  core.DEBUG,
+		FormatterConfig: &core.Config{
+			ServiceName:           "test-service",
+			Environment:           "test",
+			SanitizeSensitiveData: true,
+		},
+	})
+
+	adapter.LogTyped(context.Background(), core.INFO, "login", []core.Field{
+		core.String("password", "secret123"),
+	})
+
+	if strings.Contains(buf.String(), "secret123") {
+		t.Errorf("Expected password to be sanitized, got %s", buf.String())
+	}
+}
+
+func TestZerologAdapter_Hooks(t *testing.T) {
+	var buf bytes.Buffer
+	var seen map[string]interface{}
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Level:  core.DEBUG,
+		FormatterConfig: &core.Config{
+			ServiceName:           "test-service",
+			Environment:           "test",
+			SanitizeSensitiveData: true,
+		},
+	})
+
+	hooked := adapter.WithHooks(core.HookFunc(func(entry *core.Entry) bool {
+		seen = make(map[string]interface{}, len(entry.Fields))
+		for k, v := range entry.Fields {
+			seen[k] = v
+		}
+		entry.Fields["token"] = "added-by-hook"
+		entry.Level = core.WARN
+		return entry.Message != "drop"
+	}))
+
+	fields := map[string]interface{}{"order_id": "A-1"}
+	hooked.Log(context.Background(), core.INFO, "created", fields)
+
+	// Os hooks recebem os campos base do formatter
+	if seen["service"] != "test-service" || seen["env"] != "test" || seen["order_id"] != "A-1" {
+		t.Errorf("Expected hooks to see formatted fields, got %v", seen)
+	}
+	if len(fields) != 1 {
+		t.Errorf("Expected caller fields to be preserved, got %v", fields)
+	}
+
+	var logEntry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &logEntry); err != nil {
+		t.Fatalf("Failed to parse log output: %v", err)
+	}
+	if logEntry["level"] != "WARN" {
+		t.Errorf("Expected level changed by the hook, got %v", logEntry["level"])
+	}
+	// A sanitização é executada após os hooks
+	if logEntry["token"] == "added-by-hook" {
+		t.Errorf("Expected field added by the hook to be sanitized, got %v", logEntry["token"])
+	}
+
+	buf.Reset()
+	hooked.(*ZerologAdapter).LogTyped(context.Background(), core.INFO, "drop", []core.Field{core.String("key", "value")})
+	if buf.Len() != 0 {
+		t.Errorf("Expected entry dropped by the hook not to be written, got %s", buf.String())
+	}
+
+	// O adapter original não executa os hooks
+	adapter.Log(context.Background(), core.INFO, "plain", nil)
+	if strings.Contains(buf.String(), "added-by-hook") || strings.Contains(buf.String(), "WARN") {
+		t.Errorf("Expected original adapter to be unchanged, got %s", buf.String())
+	}
+}
+
+func TestZerologAdapter_CallerEnabled(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer:        &buf,
+		Level:         core.DEBUG,
+		CallerEnabled: true,
+	})
+	ctx := context.Background()
+
+	lastEntry := func() map[string]interface{} {
+		t.Helper()
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(lines[len(lines)-1]), &entry); err != nil {
+			t.Fatalf("Failed to parse log output: %v", err)
+		}
+		return entry
+	}
+
+	// Chamadas diretas e via LogEvent reportam o código que chamou o adapter
+	adapter.Log(ctx, core.INFO, "direct", nil)
+	adapter.LogTyped(ctx, core.INFO, "typed", nil)
+	core.NewLogEvent(adapter, ctx, core.INFO).Msg("event")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(lines))
+	}
+	for _, line := range lines {
+		if !strings.Contains(line, "zerolog_test.go:") || !strings.Contains(line, "TestZerologAdapter_CallerEnabled") {
+			t.Errorf("Expected entry to report the test as caller, got %s", line)
+		}
+	}
+
+	// Um caller já presente na entrada é preservado
+	adapter.Log(ctx, core.INFO, "explicit", map[string]interface{}{core.CallerKey: "handler.go:10"})
+	if entry := lastEntry(); entry[core.CallerKey] != "handler.go:10" {
+		t.Errorf("Expected existing caller to be preserved, got %v", entry[core.CallerKey])
+	}
+}
+
+func TestZerologAdapter_LogTypedDoesNotAllocate(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are not reliable with the race detector")
+	}
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: io.Discard,
+		Level:  core.DEBUG,
+	})
+	ctx := context.Background()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		core.NewLogEvent(adapter, ctx, core.INFO).
+			Str("user_id", "123").
+			Int("attempt", 1).
+			Msg("User login successful")
+	})
+	if allocs != 0 {
+		t.Errorf("Expected typed logging to be allocation-free, got %v allocs", allocs)
+	}
+}
+
+func BenchmarkZerologAdapter_LogEvent(b *testing.B) {
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: io.Discard,
+		Level:  core.DEBUG,
+	})
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		core.NewLogEvent(adapter, ctx, core.INFO).
+			Str("user_id", "123").
+			Int("attempt", 1).
+			Float64("duration", 1.5).
+			Bool("success", true).
+			Msg("User login successful")
+	}
+}
+
+func TestZerologAdapter_RichFieldsRenderConsistently(t *testing.T) {
+	newAdapter := func(buf *bytes.Buffer) *ZerologAdapter {
+		return NewZerologAdapter(&ZerologConfig{
+			Writer: buf,
+			Level:  core.DEBUG,
+		})
+	}
+
+	fields := []core.Field{
+		core.Int64("int64", 1<<40),
+		core.Uint64("uint64", 42),
+		core.Duration("elapsed", 1500*time.Millisecond),
+		core.Time("created_at", time.Date(2024, 5, 10, 12, 30, 0, 0, time.UTC)),
+		core.Strings("labels", []string{"a", "b"}),
+		core.Ints("ids", []int{1, 2, 3}),
+		core.Bytes("raw", []byte("payload")),
+		core.Hex("digest", []byte{0xde, 0xad}),
+		core.IPAddr("ip", net.ParseIP("10.0.0.1")),
+		core.Dict("request", core.String("method", "GET"), core.Duration("latency", time.Second)),
+		core.Array("tags", testTags{"a", "b"}),
+		core.Object("customer", testCustomer{Name: "Ana"}),
+	}
+
+	// Caminho tipado
+	var typedBuf bytes.Buffer
+	newAdapter(&typedBuf).LogTyped(context.Background(), core.INFO, "rich", fields)
+
+	// Caminho baseado em map (usado pelos enrichers ELK e Datadog)
+	var mapBuf bytes.Buffer
+	newAdapter(&mapBuf).Log(context.Background(), core.INFO, "rich", core.FieldsToMap(fields))
+
+	var typedEntry, mapEntry map[string]interface{}
+	if err := json.Unmarshal(typedBuf.Bytes(), &typedEntry); err != nil {
+		t.Fatalf("Failed to parse typed output: %v\nOutput: %s", err, typedBuf.String())
+	}
+	if err := json.Unmarshal(mapBuf.Bytes(), &mapEntry); err != nil {
+		t.Fatalf("Failed to parse map output: %v\nOutput: %s", err, mapBuf.String())
+	}
+
+	for _, field := range fields {
+		if !reflect.DeepEqual(typedEntry[field.Key], mapEntry[field.Key]) {
+			t.Errorf("Field %s rendered differently: typed=%v map=%v", field.Key, typedEntry[field.Key], mapEntry[field.Key])
+		}
+	}
+
+	if typedEntry["elapsed"] != float64(1500) {
+		t.Errorf("Expected elapsed rendered in milliseconds (1500), got %v", typedEntry["elapsed"])
+	}
+	if typedEntry["digest"] != "dead" {
+		t.Errorf("Expected digest rendered as hex, got %v", typedEntry["digest"])
+	}
+	if typedEntry["ip"] != "10.0.0.1" {
+		t.Errorf("Expected ip rendered as string, got %v", typedEntry["ip"])
+	}
+}
+
+type testStatus string
+
+func (s testStatus) String() string { return "status:" + string(s) }
+
+func TestZerologAdapter_TypedFieldsRenderEqualWithEnrichers(t *testing.T) {
+	newBase := func(buf *bytes.Buffer, sanitize bool) *ZerologAdapter {
+		return NewZerologAdapter(&ZerologConfig{
+			Writer:          buf,
+			Level:           core.DEBUG,
+			FormatterConfig: &core.Config{SanitizeSensitiveData: sanitize},
+		})
+	}
+
+	elkConfig := observability.DefaultELKConfig()
+	ecsConfig := observability.DefaultELKConfig()
+	ecsConfig.EnableECSMapping = true
+	ddConfig := observability.DefaultDatadogConfig()
+	ddConfig.MetricsEnabled = false
+	ddConfig.GlobalTags = []string{"team:backend", "region:us-east-1"}
+
+	outputs := []struct {
+		name       string
+		newAdapter func(buf *bytes.Buffer) core.LoggerAdapter
+	}{
+		{"zerolog", func(buf *bytes.Buffer) core.LoggerAdapter { return newBase(buf, false) }},
+		{"zerolog sanitized", func(buf *bytes.Buffer) core.LoggerAdapter { return newBase(buf, true) }},
+		{"elk", func(buf *bytes.Buffer) core.LoggerAdapter {
+			return observability.NewELKLoggerAdapter(newBase(buf, false), elkConfig)
+		}},
+		{"elk ecs", func(buf *bytes.Buffer) core.LoggerAdapter {
+			return observability.NewELKLoggerAdapter(newBase(buf, false), ecsConfig)
+		}},
+		{"elk sanitized", func(buf *bytes.Buffer) core.LoggerAdapter {
+			return observability.NewELKLoggerAdapter(newBase(buf, true), elkConfig)
+		}},
+		{"datadog", func(buf *bytes.Buffer) core.LoggerAdapter {
+			return observability.NewDatadogLoggerAdapter(newBase(buf, false), ddConfig)
+		}},
+	}
+
+	tests := []struct {
+		name  string
+		field core.Field
+	}{
+		{"string", core.String("value", "text")},
+		{"int", core.Int("value", 42)},
+		{"int64", core.Int64("value", 1<<40)},
+		{"float64", core.Float64("value", 1.5)},
+		{"bool", core.Bool("value", true)},
+		{"any struct", core.Any("value", struct{ ID int }{ID: 7})},
+		{"any error", core.Any("value", errors.New("boom"))},
+		{"any slice", core.Any("value", []interface{}{errors.New("boom"), []byte("raw"), net.ParseIP("10.0.0.2"), time.Second})},
+		{"uint64", core.Uint64("value", 42)},
+		{"duration", core.Duration("value", 1500*time.Millisecond)},
+		{"time", core.Time("value", time.Date(2024, 5, 10, 12, 30, 0, 123, time.UTC))},
+		{"strings", core.Strings("value", []string{"a", "b"})},
+		{"ints", core.Ints("value", []int{1, 2})},
+		{"bytes", core.Bytes("value", []byte("payload"))},
+		{"hex", core.Hex("value", []byte{0xde, 0xad})},
+		{"ip", core.IPAddr("value", net.ParseIP("10.0.0.1"))},
+		{"stringer", core.Stringer("value", testStatus("paid"))},
+		{"dict", core.Dict("value", core.Duration("latency", time.Second), core.Any("err", errors.New("boom")), core.Bytes("raw", []byte("x")))},
+		{"array", core.Array("value", testTags{"a", "b"})},
+		{"lazy", core.Func("value", func() interface{} { return time.Second })},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var expected interface{}
+			for i, output := range outputs {
+				var buf bytes.Buffer
+				adapter := output.newAdapter(&buf)
+				core.NewLogEvent(adapter, context.Background(), core.INFO).TypedFields(tt.field).Msg("typed")
+
+				var entry map[string]interface{}
+				if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+					t.Fatalf("%s: failed to parse output: %v\nOutput: %s", output.name, err, buf.String())
+				}
+				if i == 0 {
+					expected = entry["value"]
+					continue
+				}
+				if !reflect.DeepEqual(entry["value"], expected) {
+					t.Errorf("%s rendered %v, zerolog rendered %v", output.name, entry["value"], expected)
+				}
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	adapter := observability.NewDatadogLoggerAdapter(newBase(&buf, false), ddConfig)
+	core.NewLogEvent(adapter, context.Background(), core.INFO).Msg("tags")
+	if !strings.Contains(buf.String(), `"ddtags":"team:backend,region:us-east-1"`) {
+		t.Errorf("Expected global tags in ddtags, got %s", buf.String())
+	}
+}
+
+type testCustomer struct {
+	Name     string
+	Email    string
+	Password string
+}
+
+func (c testCustomer) MarshalLogObject(e core.LogEvent) {
+	e.Str("name", c.Name).Str("password", c.Password)
+}
+
+type testTags []string
+
+func (t testTags) MarshalLogArray(a core.LogArray) {
+	for _, tag := range t {
+		a.Str(tag)
+	}
+}
+
+func TestZerologAdapter_ObjectMarshaler(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Level:  core.DEBUG,
+		FormatterConfig: &core.Config{
+			ServiceName:           "test-service",
+			Environment:           "test",
+			SanitizeSensitiveData: true,
+		},
+	})
+
+	customer := testCustomer{Name: "Ana", Email: "ana@example.com", Password: "secret123"}
+	core.NewLogEvent(adapter, context.Background(), core.INFO).
+		Object("customer", customer).
+		Array("tags", testTags{"vip", "beta"}).
+		Msg("customer updated")
+
+	output := buf.String()
+	if strings.Contains(output, "ana@example.com") {
+		t.Errorf("Expected fields not marshaled by the object to be omitted, got %s", output)
+	}
+	if strings.Contains(output, "secret123") {
+		t.Errorf("Expected nested password to be sanitized, got %s", output)
+	}
+
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Failed to parse log output: %v\nOutput: %s", err, output)
+	}
+	customerEntry, ok := entry["customer"].(map[string]interface{})
+	if !ok || customerEntry["name"] != "Ana" {
+		t.Errorf("Expected customer.name='Ana', got %v", entry["customer"])
+	}
+	if !reflect.DeepEqual(entry["tags"], []interface{}{"vip", "beta"}) {
+		t.Errorf("Expected tags=[vip beta], got %v", entry["tags"])
+	}
+}
+
+func TestZerologAdapter_AtomicLevel(t *testing.T) {
+	var buf bytes.Buffer
+	level := core.NewAtomicLevel(core.INFO)
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer:      &buf,
+		Level:       core.ERROR, // ignorado quando AtomicLevel é definido
+		AtomicLevel: level,
+	})
+	derived := adapter.WithContext(context.Background())
+
+	derived.Log(context.Background(), core.DEBUG, "before", nil)
+	if buf.Len() != 0 {
+		t.Errorf("Expected DEBUG to be filtered, got %s", buf.String())
+	}
+
+	level.SetLevel(core.DEBUG)
+	if !derived.IsLevelEnabled(core.DEBUG) {
+		t.Error("Expected derived adapter to observe the new level")
+	}
+	derived.Log(context.Background(), core.DEBUG, "after", nil)
+	if !strings.Contains(buf.String(), "after") {
+		t.Errorf("Expected DEBUG message after SetLevel, got %s", buf.String())
+	}
+
+	if adapter.Level() != level {
+		t.Error("Expected Level() to return the configured AtomicLevel")
+	}
+}
+
+func TestZerologAdapter_ComponentLevels(t *testing.T) {
+	var buf bytes.Buffer
+	levels := core.NewLevelRegistry(core.NewAtomicLevel(core.INFO))
+	levels.SetComponentLevel("pgx", core.WARN)
+	levels.SetComponentLevel("http_middleware", core.DEBUG)
+
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Levels: levels,
+	})
+	ctx := context.Background()
+
+	// IsLevelEnabled usa o nível global; os overrides são resolvidos por componente
+	if adapter.IsLevelEnabled(core.DEBUG) {
+		t.Error("Expected DEBUG to be disabled at the global level")
+	}
+	if !adapter.IsComponentLevelEnabled("http_middleware", core.DEBUG) {
+		t.Error("Expected DEBUG to be enabled for http_middleware")
+	}
+
+	adapter.Log(ctx, core.INFO, "pgx info", map[string]interface{}{"component": "pgx"})
+	adapter.LogTyped(ctx, core.DEBUG, "http debug", []core.Field{core.String("component", "http_middleware")})
+	adapter.Log(ctx, core.DEBUG, "global debug", nil)
+	adapter.Log(ctx, core.INFO, "global info", nil)
+
+	output := buf.String()
+	if strings.Contains(output, "pgx info") {
+		t.Error("Expected INFO to be filtered for pgx")
+	}
+	if !strings.Contains(output, "http debug") {
+		t.Error("Expected DEBUG to be written for http_middleware")
+	}
+	if strings.Contains(output, "global debug") {
+		t.Error("Expected DEBUG to be filtered without component")
+	}
+	if !strings.Contains(output, "global info") {
+		t.Error("Expected INFO to be written without component")
+	}
+}
+
+func TestZerologAdapter_TraceAndCustomLevels(t *testing.T) {
+	var buf bytes.Buffer
+	notice := core.MustRegisterLevel("NOTICE", 2)
+	adapter := NewZerologAdapter(&ZerologConfig{
+		Writer: &buf,
+		Level:  core.TRACE,
+	})
+	ctx := context.Background()
+
+	adapter.Log(ctx, core.TRACE, "trace message", nil)
+	adapter.Log(ctx, notice, "notice message", nil)
+	adapter.Log(ctx, core.PANIC, "panic message", nil) // o adapter apenas registra
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 log lines, got %d: %s", len(lines), buf.String())
+	}
+
+	expected := []string{"TRACE", "NOTICE", "PANIC"}
+	for i, line := range lines {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Failed to parse log line: %v\nLine: %s", err, line)
+		}
+		if entry["level"] != expected[i] {
+			t.Errorf("Expected level %s, got %v", expected[i], entry["level"])
+		}
+	}
+
+	// Níveis customizados respeitam o nível mínimo pela severidade
+	buf.Reset()
+	adapter.Level().SetLevel(core.WARN)
+	adapter.Log(ctx, notice, "filtered", nil)
+	if buf.Len() != 0 {
+		t.Errorf("Expected NOTICE to be filtered with WARN level, got %s", buf.String())
+	}
+}
+
+// flushingWriter simula um writer com buffer e um recurso a ser fechado
+type flushingWriter struct {
+	bytes.Buffer
+	flushed bool
+	closed  bool
+}
+
+func (w *flushingWriter) Flush(ctx context.Context) error {
+	w.flushed = true
+	return nil
+}
+
+func (w *flushingWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestZerologAdapter_FlushAndClose(t *testing.T) {
+	writer := &flushingWriter{}
+	adapter := NewZerologAdapter(&ZerologConfig{Writer: writer, Level: core.INFO})
+
+	if err := core.FlushAdapter(context.Background(), adapter.WithContext(context.Background())); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if !writer.flushed {
+		t.Error("Expected Flush to reach the configured writer")
+	}
+
+	// Sem Closer configurado o writer não é fechado
+	if err := adapter.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if writer.closed {
+		t.Error("Expected the writer not to be closed without ZerologConfig.Closer")
+	}
+
+	closer := &flushingWriter{}
+	adapter = NewZerologAdapter(&ZerologConfig{Writer: writer, Closer: closer})
+	if err := core.CloseAdapter(adapter); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if !closer.closed {
+		t.Error("Expected Close to release ZerologConfig.Closer")
+	}
+}
+
+// levelRecorder registra o nível recebido por WriteLevel
+type levelRecorder struct {
+	levels []core.Level
+}
+
+func (w *levelRecorder) Write(p []byte) (int, error) {
+	return w.WriteLevel(core.INFO, p)
+}
+
+func (w *levelRecorder) WriteLevel(level core.Level, p []byte) (int, error) {
+	w.levels = append(w.levels, level)
+	return len(p), nil
+}
+
+func TestZerologAdapter_LevelWriter(t *testing.T) {
+	writer := &levelRecorder{}
+	adapter := NewZerologAdapter(&ZerologConfig{Writer: writer, Level: core.DEBUG})
+	ctx := context.Background()
+
+	adapter.Log(ctx, core.DEBUG, "debug", nil)
+	adapter.Log(ctx, core.WARN, "warn", nil)
+	adapter.Log(ctx, core.ERROR, "error", nil)
+
+	expected := []core.Level{core.DEBUG, core.WARN, core.ERROR}
+	if !reflect.DeepEqual(writer.levels, expected) {
+		t.Errorf("Expected levels %v, got %v", expected, writer.levels)
+	}
+}
+
+func TestZerologAdapter_Lazy(t *testing.T) {
+	var buf bytes.Buffer
+	adapter := NewZerologAdapter(&ZerologConfig{Writer: &buf, Level: core.INFO})
+	ctx := context.Background()
+	calls := 0
+	snapshot := core.Lazy(func() interface{} {
+		calls++
+		return map[string]interface{}{"method": "GET"}
+	})
+
+	adapter.LogTyped(ctx, core.DEBUG, "disabled", []core.Field{core.Any("request", snapshot)})
+	if calls != 0 || buf.Len() != 0 {
+		t.Fatalf("Expected disabled entry not to resolve the value, got %d calls", calls)
+	}
+
+	adapter.LogTyped(ctx, core.INFO, "typed", []core.Field{
+		core.Any("request", snapshot),
+		core.Func("size", func() interface{} { return 42 }),
+	})
+	adapter.Log(ctx, core.INFO, "map", map[string]interface{}{"request": snapshot})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(lines))
+	}
+	for _, line := range lines {
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Failed to parse entry: %v", err)
+		}
+		request, _ := entry["request"].(map[string]interface{})
+		if request["method"] != "GET" {
+			t.Errorf("Expected resolved request field, got %v", entry["request"])
+		}
+	}
+	if !strings.Contains(lines[0], `"size":42`) {
+		t.Errorf("Expected resolved Func field, got %s", lines[0])
+	}
+	if calls != 2 {
+		t.Errorf("Expected one resolution per written entry, got %d", calls)
+	}
+}
@@ -0,0 +1,274 @@
+// Package admin fornece handlers HTTP para administração do logger em tempo
+// de execução, destinados a portas internas (ao lado de /metrics).
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/victorximenis/logger"
+	"github.com/victorximenis/logger/core"
+)
+
+// maxLevelRequestSize é o tamanho máximo do corpo das requisições PUT
+const maxLevelRequestSize = 1 << 10
+
+// LevelHandlerConfig define a configuração do handler de níveis de log
+type LevelHandlerConfig struct {
+	// Levels é o registry cujos níveis serão expostos e alterados
+	Levels *core.LevelRegistry
+	// Logger é usado para registrar eventos de auditoria das alterações.
+	// Se nil, os eventos são registrados no logger global.
+	Logger core.LoggerAdapter
+	// AuditLevel define o nível dos eventos de auditoria.
+	// DefaultLevelHandlerConfig usa WARN, para que permaneçam visíveis com a
+	// configuração usual de produção; o valor zero é INFO.
+	AuditLevel core.Level
+}
+
+// DefaultLevelHandlerConfig retorna uma configuração padrão para o handler de níveis
+func DefaultLevelHandlerConfig(levels *core.LevelRegistry, logger core.LoggerAdapter) LevelHandlerConfig {
+	return LevelHandlerConfig{
+		Levels:     levels,
+		Logger:     logger,
+		AuditLevel: core.WARN,
+	}
+}
+
+// WithAuditLevel configura o nível dos eventos de auditoria
+func (c LevelHandlerConfig) WithAuditLevel(level core.Level) LevelHandlerConfig {
+	c.AuditLevel = level
+	return c
+}
+
+// LevelHandler expõe o nível global e os overrides por componente via JSON.
+//
+// Rotas (relativas ao ponto de montagem):
+//
+//	GET    /             nível global e overrides por componente
+//	PUT    /             altera o nível global: {"level": "debug"}
+//	GET    /{component}  nível efetivo do componente
+//	PUT    /{component}  define o override do componente: {"level": "warn"}
+//	DELETE /{component}  remove o override do componente
+//
+// Exemplo:
+//
+//	mux.Handle("/admin/levels/", http.StripPrefix("/admin/levels",
+//		admin.NewLevelHandler(admin.DefaultLevelHandlerConfig(logger.Levels(), adapter))))
+type LevelHandler struct {
+	config LevelHandlerConfig
+}
+
+// NewLevelHandler cria um novo LevelHandler com a configuração especificada
+func NewLevelHandler(config LevelHandlerConfig) *LevelHandler {
+	if config.Levels == nil {
+		config.Levels = core.NewLevelRegistry(nil)
+	}
+	return &LevelHandler{config: config}
+}
+
+// levelsResponse é a representação JSON do estado do registry
+type levelsResponse struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
+
+// componentResponse é a representação JSON do nível de um componente
+type componentResponse struct {
+	Component string `json:"component"`
+	Level     string `json:"level"`
+	Override  bool   `json:"override"`
+}
+
+// levelRequest é o corpo esperado nas requisições PUT
+type levelRequest struct {
+	Level string `json:"level"`
+}
+
+// errorResponse é a representação JSON de um erro
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP implementa a interface http.Handler
+func (h *LevelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	component := strings.Trim(r.URL.Path, "/")
+
+	if component == "" {
+		switch r.Method {
+		case http.MethodGet:
+			h.writeJSON(w, http.StatusOK, h.levels())
+		case http.MethodPut:
+			h.setGlobal(w, r)
+		default:
+			h.methodNotAllowed(w, "GET, PUT")
+		}
+		return
+	}
+
+	if strings.Contains(component, "/") {
+		h.writeError(w, http.StatusNotFound, fmt.Errorf("invalid component: %q", component))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.writeJSON(w, http.StatusOK, h.component(component))
+	case http.MethodPut:
+		h.setComponent(w, r, component)
+	case http.MethodDelete:
+		h.removeComponent(w, r, component)
+	default:
+		h.methodNotAllowed(w, "GET, PUT, DELETE")
+	}
+}
+
+// setGlobal altera o nível global
+func (h *LevelHandler) setGlobal(w http.ResponseWriter, r *http.Request) {
+	level, err := decodeLevel(w, r)
+	if err != nil {
+		h.writeDecodeError(w, err)
+		return
+	}
+
+	previous := h.config.Levels.Level()
+	h.applyAudited(r, "", previous, level, func() {
+		h.config.Levels.SetLevel(level)
+	})
+
+	h.writeJSON(w, http.StatusOK, h.levels())
+}
+
+// setComponent define o override de nível do componente
+func (h *LevelHandler) setComponent(w http.ResponseWriter, r *http.Request, component string) {
+	level, err := decodeLevel(w, r)
+	if err != nil {
+		h.writeDecodeError(w, err)
+		return
+	}
+
+	previous, _ := h.config.Levels.ComponentLevel(component)
+	h.applyAudited(r, component, previous, level, func() {
+		h.config.Levels.SetComponentLevel(component, level)
+	})
+
+	h.writeJSON(w, http.StatusOK, h.component(component))
+}
+
+// removeComponent remove o override de nível do componente
+func (h *LevelHandler) removeComponent(w http.ResponseWriter, r *http.Request, component string) {
+	previous, ok := h.config.Levels.ComponentLevel(component)
+	if !ok {
+		h.writeError(w, http.StatusNotFound, fmt.Errorf("no level override for component %q", component))
+		return
+	}
+
+	h.applyAudited(r, component, previous, h.config.Levels.Level(), func() {
+		h.config.Levels.RemoveComponentLevel(component)
+	})
+
+	h.writeJSON(w, http.StatusOK, h.component(component))
+}
+
+// levels retorna o estado atual do registry
+func (h *LevelHandler) levels() levelsResponse {
+	components := make(map[string]string)
+	for component, level := range h.config.Levels.ComponentLevels() {
+		components[component] = level.String()
+	}
+	return levelsResponse{
+		Level:      h.config.Levels.Level().String(),
+		Components: components,
+	}
+}
+
+// component retorna o nível efetivo do componente
+func (h *LevelHandler) component(component string) componentResponse {
+	level, override := h.config.Levels.ComponentLevel(component)
+	return componentResponse{
+		Component: component,
+		Level:     level.String(),
+		Override:  override,
+	}
+}
+
+// applyAudited aplica a alteração de nível e registra o evento de auditoria
+// enquanto o nível mais verboso entre o anterior e o novo está em vigor, para
+// que o evento não seja descartado quando o novo nível for menos verboso que
+// AuditLevel
+func (h *LevelHandler) applyAudited(r *http.Request, component string, previous, current core.Level, apply func()) {
+	if current > previous {
+		h.audit(r, component, previous.String(), current.String())
+		apply()
+		return
+	}
+	apply()
+	h.audit(r, component, previous.String(), current.String())
+}
+
+// audit registra a alteração de nível como evento de auditoria, no logger
+// configurado ou, na ausência, no logger global
+func (h *LevelHandler) audit(r *http.Request, component, previous, current string) {
+	adapter := h.config.Logger
+	if adapter == nil {
+		adapter = logger.GetAdapter()
+	}
+
+	scope := component
+	if scope == "" {
+		scope = "global"
+	}
+
+	adapter.Log(r.Context(), h.config.AuditLevel, "Log level changed", map[string]interface{}{
+		"event":          "log_level_changed",
+		"audit":          true,
+		"scope":          scope,
+		"previous_level": previous,
+		"new_level":      current,
+		"remote_addr":    r.RemoteAddr,
+		"user_agent":     r.UserAgent(),
+	})
+}
+
+// decodeLevel lê e valida o nível do corpo da requisição, limitado a
+// maxLevelRequestSize bytes
+func decodeLevel(w http.ResponseWriter, r *http.Request) (core.Level, error) {
+	var req levelRequest
+	body := http.MaxBytesReader(w, r.Body, maxLevelRequestSize)
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return core.INFO, fmt.Errorf("invalid request body: %w", err)
+	}
+	return core.ParseLevel(req.Level)
+}
+
+// writeDecodeError responde com 413 se o corpo exceder o limite e com 400
+// nos demais erros de decodificação
+func (h *LevelHandler) writeDecodeError(w http.ResponseWriter, err error) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		h.writeError(w, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	h.writeError(w, http.StatusBadRequest, err)
+}
+
+// methodNotAllowed responde com 405 e os métodos permitidos
+func (h *LevelHandler) methodNotAllowed(w http.ResponseWriter, allowed string) {
+	w.Header().Set("Allow", allowed)
+	h.writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method not allowed"))
+}
+
+// writeError escreve um erro em JSON
+func (h *LevelHandler) writeError(w http.ResponseWriter, status int, err error) {
+	h.writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// writeJSON escreve a resposta em JSON
+func (h *LevelHandler) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,184 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/victorximenis/logger"
+	"github.com/victorximenis/logger/adapters"
+	"github.com/victorximenis/logger/core"
+)
+
+// auditAdapter registra as chamadas de log para verificação nos testes
+type auditAdapter struct {
+	calls []map[string]interface{}
+}
+
+func (a *auditAdapter) Log(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
+	a.calls = append(a.calls, fields)
+}
+
+func (a *auditAdapter) WithContext(ctx context.Context) core.LoggerAdapter { return a }
+
+func (a *auditAdapter) IsLevelEnabled(level core.Level) bool { return true }
+
+func doRequest(t *testing.T, h http.Handler, method, path, body string) (*httptest.ResponseRecorder, map[string]interface{}) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v\nBody: %s", err, rec.Body.String())
+	}
+	return rec, resp
+}
+
+func TestLevelHandler_Global(t *testing.T) {
+	levels := core.NewLevelRegistry(core.NewAtomicLevel(core.INFO))
+	audit := &auditAdapter{}
+	h := NewLevelHandler(DefaultLevelHandlerConfig(levels, audit))
+
+	rec, resp := doRequest(t, h, http.MethodGet, "/", "")
+	if rec.Code != http.StatusOK || resp["level"] != "INFO" {
+		t.Errorf("Expected 200 with level INFO, got %d %v", rec.Code, resp)
+	}
+
+	rec, resp = doRequest(t, h, http.MethodPut, "/", `{"level":"debug"}`)
+	if rec.Code != http.StatusOK || resp["level"] != "DEBUG" {
+		t.Errorf("Expected 200 with level DEBUG, got %d %v", rec.Code, resp)
+	}
+	if levels.Level() != core.DEBUG {
+		t.Errorf("Expected registry level DEBUG, got %s", levels.Level())
+	}
+
+	if len(audit.calls) != 1 {
+		t.Fatalf("Expected 1 audit event, got %d", len(audit.calls))
+	}
+	if audit.calls[0]["previous_level"] != "INFO" || audit.calls[0]["new_level"] != "DEBUG" || audit.calls[0]["scope"] != "global" {
+		t.Errorf("Unexpected audit fields: %v", audit.calls[0])
+	}
+}
+
+func TestLevelHandler_Component(t *testing.T) {
+	levels := core.NewLevelRegistry(core.NewAtomicLevel(core.INFO))
+	h := NewLevelHandler(DefaultLevelHandlerConfig(levels, nil))
+
+	_, resp := doRequest(t, h, http.MethodGet, "/pgx", "")
+	if resp["level"] != "INFO" || resp["override"] != false {
+		t.Errorf("Expected inherited INFO level, got %v", resp)
+	}
+
+	rec, resp := doRequest(t, h, http.MethodPut, "/pgx", `{"level":"warning"}`)
+	if rec.Code != http.StatusOK || resp["level"] != "WARN" || resp["override"] != true {
+		t.Errorf("Expected WARN override, got %d %v", rec.Code, resp)
+	}
+
+	_, resp = doRequest(t, h, http.MethodGet, "/", "")
+	components, _ := resp["components"].(map[string]interface{})
+	if components["pgx"] != "WARN" {
+		t.Errorf("Expected pgx=WARN in components, got %v", resp["components"])
+	}
+
+	rec, _ = doRequest(t, h, http.MethodDelete, "/pgx", "")
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected 200 on delete, got %d", rec.Code)
+	}
+	if _, ok := levels.ComponentLevel("pgx"); ok {
+		t.Error("Expected pgx override to be removed")
+	}
+
+	rec, _ = doRequest(t, h, http.MethodDelete, "/pgx", "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 when removing missing override, got %d", rec.Code)
+	}
+}
+
+func TestLevelHandler_Errors(t *testing.T) {
+	levels := core.NewLevelRegistry(core.NewAtomicLevel(core.INFO))
+	h := NewLevelHandler(DefaultLevelHandlerConfig(levels, nil))
+
+	rec, resp := doRequest(t, h, http.MethodPut, "/", `{"level":"verbose"}`)
+	if rec.Code != http.StatusBadRequest || resp["error"] == nil {
+		t.Errorf("Expected 400 for invalid level, got %d %v", rec.Code, resp)
+	}
+	if levels.Level() != core.INFO {
+		t.Errorf("Expected level to remain INFO, got %s", levels.Level())
+	}
+
+	rec, _ = doRequest(t, h, http.MethodPut, "/", `not json`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for invalid body, got %d", rec.Code)
+	}
+
+	rec, _ = doRequest(t, h, http.MethodPut, "/", `{"level":"`+strings.Repeat("d", maxLevelRequestSize)+`"}`)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413 for oversized body, got %d", rec.Code)
+	}
+
+	rec, _ = doRequest(t, h, http.MethodPost, "/", "")
+	if rec.Code != http.StatusMethodNotAllowed || rec.Header().Get("Allow") == "" {
+		t.Errorf("Expected 405 with Allow header, got %d", rec.Code)
+	}
+}
+
+func TestLevelHandler_AuditFallsBackToGlobalLogger(t *testing.T) {
+	config := logger.NewConfig()
+	config.Output = logger.OutputFile
+	config.LogFilePath = filepath.Join(t.TempDir(), "audit.log")
+	config.Observability.Enabled = false
+	if err := logger.Init(config); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	levels := core.NewLevelRegistry(core.NewAtomicLevel(core.INFO))
+	h := NewLevelHandler(DefaultLevelHandlerConfig(levels, nil))
+
+	rec, _ := doRequest(t, h, http.MethodPut, "/db", `{"level":"debug"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+
+	if err := logger.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	content, err := os.ReadFile(config.LogFilePath)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if !strings.Contains(string(content), `"event":"log_level_changed"`) || !strings.Contains(string(content), `"scope":"db"`) {
+		t.Errorf("Expected audit event in the global logger, got %q", content)
+	}
+}
+
+func TestLevelHandler_AuditsLevelAboveAuditLevel(t *testing.T) {
+	var buf bytes.Buffer
+	levels := core.NewLevelRegistry(core.NewAtomicLevel(core.INFO))
+	adapter := adapters.NewZerologAdapter(&adapters.ZerologConfig{Writer: &buf, Levels: levels})
+	h := NewLevelHandler(DefaultLevelHandlerConfig(levels, adapter))
+
+	// ERROR está acima do AuditLevel padrão (WARN)
+	if rec, _ := doRequest(t, h, http.MethodPut, "/", `{"level":"error"}`); rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(buf.String(), `"new_level":"ERROR"`) {
+		t.Errorf("Expected audit event when raising the level to ERROR, got %q", buf.String())
+	}
+
+	buf.Reset()
+	if rec, _ := doRequest(t, h, http.MethodPut, "/", `{"level":"debug"}`); rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	if !strings.Contains(buf.String(), `"previous_level":"ERROR"`) {
+		t.Errorf("Expected audit event when lowering the level from ERROR, got %q", buf.String())
+	}
+}
@@ -2,11 +2,13 @@ package logger
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/victorximenis/logger/adapters"
 	"github.com/victorximenis/logger/core"
@@ -48,14 +50,41 @@ type Config struct {
 	Output OutputType
 	// LogLevel define o nível mínimo de log que será registrado
 	LogLevel core.Level
+	// ComponentLevels define overrides do nível mínimo por componente
+	// (ex: {"pgx": core.WARN}), aplicados aos loggers criados via Named e
+	// às entradas com o campo "component" correspondente
+	ComponentLevels map[string]core.Level
 	// LogFilePath define o caminho do arquivo de log quando Output inclui OutputFile
 	LogFilePath string
 	// TenantID é um identificador opcional para multi-tenancy
 	TenantID string
 	// PrettyPrint habilita formatação legível para desenvolvimento
 	PrettyPrint bool
-	// CallerEnabled habilita informações do caller nos logs
+	// CallerEnabled habilita informações do caller nos logs (campos "caller" e "function")
 	CallerEnabled bool
+	// CallerSkip define quantos frames adicionais devem ser ignorados ao
+	// identificar o caller, para aplicações que encapsulam o logger
+	CallerSkip int
+	// StackTrace define quando os stack traces são capturados no call site
+	// de Err para erros que não carregam um próprio (padrão: ERROR e acima)
+	StackTrace core.StackTracePolicy
+	// Async configura a escrita assíncrona, que desacopla as chamadas de log
+	// do I/O através de uma fila limitada (padrão: desabilitada)
+	Async core.AsyncConfig
+	// Dedup configura a supressão de entradas duplicadas dentro de uma
+	// janela de tempo, com resumos periódicos (padrão: desabilitada)
+	Dedup core.DedupConfig
+	// Sampler reduz o volume de entradas registradas sem alterar os call
+	// sites (ex: core.NewLevelSampler). FATAL e PANIC nunca são amostradas.
+	Sampler core.Sampler
+	// RateLimit limita as entradas de cada call site a RateLimit por
+	// segundo (padrão: 0, sem limite). Pode ser sobrescrito por entrada via
+	// LogEvent.RateLimited.
+	RateLimit float64
+	// Hooks são executados em ordem sobre cada entrada antes da escrita,
+	// após a formatação e o enriquecimento de observabilidade, podendo
+	// modificar ou descartar a entrada (ex: core.NewSanitizeHook)
+	Hooks []core.Hook
 	// Observability define as configurações de observabilidade
 	Observability observability.ObservabilityConfig
 }
@@ -84,6 +113,9 @@ const (
 	EnvOutput = "LOGGER_OUTPUT"
 	// EnvLogLevel é o nome da variável de ambiente para o nível de log
 	EnvLogLevel = "LOGGER_LOG_LEVEL"
+	// EnvLevels é o nome da variável de ambiente para os níveis por componente
+	// no formato "pgx=warn,http_middleware=debug"
+	EnvLevels = "LOGGER_LEVELS"
 	// EnvLogFilePath é o nome da variável de ambiente para o caminho do arquivo de log
 	EnvLogFilePath = "LOGGER_LOG_FILE_PATH"
 	// EnvTenantID é o nome da variável de ambiente para o tenant ID
@@ -92,6 +124,26 @@ const (
 	EnvPrettyPrint = "LOGGER_PRETTY_PRINT"
 	// EnvCallerEnabled é o nome da variável de ambiente para habilitar caller
 	EnvCallerEnabled = "LOGGER_CALLER_ENABLED"
+	// EnvStackTrace é o nome da variável de ambiente para a política de
+	// stack traces ("never", "error" ou "always")
+	EnvStackTrace = "LOGGER_STACK_TRACE"
+	// EnvAsync é o nome da variável de ambiente para habilitar a escrita assíncrona
+	EnvAsync = "LOGGER_ASYNC"
+	// EnvAsyncBufferSize é o nome da variável de ambiente para a capacidade da fila assíncrona
+	EnvAsyncBufferSize = "LOGGER_ASYNC_BUFFER_SIZE"
+	// EnvAsyncOverflow é o nome da variável de ambiente para a política de
+	// overflow ("block", "drop_newest" ou "drop_oldest")
+	EnvAsyncOverflow = "LOGGER_ASYNC_OVERFLOW"
+	// EnvDedup é o nome da variável de ambiente para habilitar a supressão de duplicatas
+	EnvDedup = "LOGGER_DEDUP"
+	// EnvDedupWindow é o nome da variável de ambiente para a janela de supressão (ex: "30s")
+	EnvDedupWindow = "LOGGER_DEDUP_WINDOW"
+	// EnvDedupFields é o nome da variável de ambiente para os campos que
+	// compõem a chave de identificação (ex: "component,error")
+	EnvDedupFields = "LOGGER_DEDUP_FIELDS"
+	// EnvRateLimit é o nome da variável de ambiente para o limite de
+	// entradas por segundo por call site
+	EnvRateLimit = "LOGGER_RATE_LIMIT"
 	// EnvObservabilityEnabled é o nome da variável de ambiente para habilitar observabilidade
 	EnvObservabilityEnabled = "LOGGER_OBSERVABILITY_ENABLED"
 )
@@ -100,8 +152,15 @@ const (
 var (
 	defaultLogger Logger
 	defaultConfig Config
+	// defaultOutput é o OutputManager do logger global criado por Init
+	defaultOutput *core.OutputManager
 	initMutex     sync.RWMutex
 	isInitialized bool
+	// globalLevel é compartilhado por todos os adapters criados pelo logger
+	// global, permitindo alterar o nível em tempo de execução via SetLevel
+	globalLevel = core.NewAtomicLevel(DefaultLogLevel)
+	// globalLevels contém os overrides de nível por componente do logger global
+	globalLevels = core.NewLevelRegistry(globalLevel)
 )
 
 // NewConfig cria uma nova configuração com valores padrão
@@ -115,6 +174,9 @@ func NewConfig() Config {
 		TenantID:      "",
 		PrettyPrint:   false,
 		CallerEnabled: false,
+		StackTrace:    core.StackTraceOnError,
+		Async:         core.DefaultAsyncConfig(),
+		Dedup:         core.DefaultDedupConfig(),
 		Observability: observability.DefaultObservabilityConfig(),
 	}
 }
@@ -131,15 +193,20 @@ func LoadConfigFromEnv() Config {
 	}
 
 	config := Config{
-		ServiceName:   getEnv(EnvServiceName, DefaultServiceName),
-		Environment:   getEnv(EnvEnvironment, DefaultEnvironment),
-		Output:        parseOutputType(getEnv(EnvOutput, "stdout")),
-		LogLevel:      parseLogLevel(getEnv(EnvLogLevel, "info")),
-		LogFilePath:   getEnv(EnvLogFilePath, DefaultLogFilePath),
-		TenantID:      getEnv(EnvTenantID, ""),
-		PrettyPrint:   parseBool(getEnv(EnvPrettyPrint, "false")),
-		CallerEnabled: parseBool(getEnv(EnvCallerEnabled, "false")),
-		Observability: observabilityConfig,
+		ServiceName:     getEnv(EnvServiceName, DefaultServiceName),
+		Environment:     getEnv(EnvEnvironment, DefaultEnvironment),
+		Output:          parseOutputType(getEnv(EnvOutput, "stdout")),
+		LogLevel:        parseLogLevel(getEnv(EnvLogLevel, "info")),
+		ComponentLevels: parseComponentLevels(getEnv(EnvLevels, "")),
+		LogFilePath:     getEnv(EnvLogFilePath, DefaultLogFilePath),
+		TenantID:        getEnv(EnvTenantID, ""),
+		PrettyPrint:     parseBool(getEnv(EnvPrettyPrint, "false")),
+		CallerEnabled:   parseBool(getEnv(EnvCallerEnabled, "false")),
+		StackTrace:      parseStackTracePolicy(getEnv(EnvStackTrace, "error")),
+		Async:           parseAsyncConfig(),
+		Dedup:           parseDedupConfig(),
+		RateLimit:       parseRateLimit(getEnv(EnvRateLimit, "0")),
+		Observability:   observabilityConfig,
 	}
 
 	// Sincronizar configurações entre logger e observabilidade
@@ -174,11 +241,29 @@ func Init(config Config) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	// Criar adapter baseado na configuração
-	adapter, err := createAdapterFromConfig(config)
+	// Descartar o pipeline anterior antes de criar o novo: recursos globais
+	// como o cliente statsd e o tracer do Datadog são encerrados pelo Close
+	// do pipeline anterior e não podem ser compartilhados com o novo
+	if isInitialized {
+		ctx, cancel := context.WithTimeout(context.Background(), core.DefaultShutdownTimeout)
+		err := closeLogger(ctx, defaultLogger)
+		cancel()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "logger: failed to dispose previous logger: %v\n", err)
+		}
+		defaultLogger = nil
+		defaultOutput = nil
+		isInitialized = false
+	}
+
+	// Criar adapter baseado na configuração. Em caso de erro, o logger
+	// global volta ao padrão em stdout.
+	adapter, output, err := createAdapterFromConfig(config)
 	if err != nil {
 		return fmt.Errorf("failed to create adapter: %w", err)
 	}
+	globalLevel.SetLevel(config.LogLevel)
+	applyComponentLevels(config.ComponentLevels)
 
 	// Criar logger com campos pré-definidos baseados na configuração
 	preDefinedFields := map[string]interface{}{
@@ -191,13 +276,65 @@ func Init(config Config) error {
 	}
 
 	// Criar logger global
-	defaultLogger = New(adapter).WithFields(preDefinedFields)
+	defaultLogger = NewWithOptions(adapter, config.eventOptions()).WithFields(preDefinedFields)
 	defaultConfig = config
+	defaultOutput = output
 	isInitialized = true
 
 	return nil
 }
 
+// Shutdown descarrega as entradas pendentes e libera os recursos do logger
+// global: arquivos de log, cliente statsd e tracer do Datadog. Deve ser
+// chamada no encerramento gracioso da aplicação. Entradas registradas após
+// o Shutdown usam um logger padrão em stdout.
+func Shutdown(ctx context.Context) error {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	if !isInitialized {
+		return nil
+	}
+
+	err := closeLogger(ctx, defaultLogger)
+	defaultLogger = nil
+	defaultOutput = nil
+	isInitialized = false
+
+	return err
+}
+
+// Flush descarrega as entradas mantidas em buffer pelo logger global
+func Flush(ctx context.Context) error {
+	return GetLogger().Flush(ctx)
+}
+
+// AsyncStats retorna os contadores da escrita assíncrona do logger global
+// (entradas escritas, descartadas por overflow e pendentes). Os contadores
+// são zero quando a escrita assíncrona está desabilitada ou o logger global
+// não foi inicializado via Init.
+func AsyncStats() core.AsyncStats {
+	initMutex.RLock()
+	defer initMutex.RUnlock()
+
+	if defaultOutput == nil {
+		return core.AsyncStats{}
+	}
+	return defaultOutput.AsyncStats()
+}
+
+// closeLogger descarrega e fecha o logger, combinando os erros
+func closeLogger(ctx context.Context, l Logger) error {
+	var errs []error
+	if err := l.Flush(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("failed to flush logger: %w", err))
+	}
+	if err := l.Close(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to close logger: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
 // InitFromEnv inicializa o logger global carregando a configuração de variáveis de ambiente
 func InitFromEnv() error {
 	config, err := LoadConfigFromEnvWithValidation()
@@ -242,11 +379,12 @@ func GetLogger() Logger {
 	}
 
 	// Criar logger básico diretamente sem chamar Init para evitar deadlock
+	// Preservar o nível definido via SetLevel antes da inicialização
 	config := NewConfig()
+	config.LogLevel = globalLevel.Level()
 	adapter := adapters.NewZerologAdapter(&adapters.ZerologConfig{
-		Level:         config.LogLevel,
-		PrettyPrint:   config.PrettyPrint,
-		CallerEnabled: config.CallerEnabled,
+		Levels:      globalLevels,
+		PrettyPrint: config.PrettyPrint,
 	})
 
 	// Criar logger com campos pré-definidos baseados na configuração
@@ -255,15 +393,43 @@ func GetLogger() Logger {
 		"environment": config.Environment,
 	}
 
-	defaultLogger = New(adapter).WithFields(preDefinedFields)
+	defaultLogger = NewWithOptions(adapter, config.eventOptions()).WithFields(preDefinedFields)
 	defaultConfig = config
 	isInitialized = true
 
 	return defaultLogger
 }
 
+// SetLevel altera o nível mínimo do logger global em tempo de execução.
+// A alteração tem efeito imediato em todos os loggers derivados via
+// WithFields e WithContext, sem recriar os writers.
+func SetLevel(level core.Level) {
+	initMutex.Lock()
+	defer initMutex.Unlock()
+
+	globalLevel.SetLevel(level)
+	defaultConfig.LogLevel = level
+}
+
+// GetLevel retorna o nível mínimo atual do logger global
+func GetLevel() core.Level {
+	return globalLevel.Level()
+}
+
+// Levels retorna o registry de níveis do logger global, que permite consultar
+// e alterar o nível global e os overrides por componente em tempo de execução.
+// Pode ser exposto via HTTP com o pacote admin.
+func Levels() *core.LevelRegistry {
+	return globalLevels
+}
+
 // Funções helper globais para logging
 
+// Trace retorna um LogEvent para nível TRACE usando o logger global
+func Trace(ctx context.Context) core.LogEvent {
+	return GetLogger().Trace(ctx)
+}
+
 // Debug retorna um LogEvent para nível DEBUG usando o logger global
 func Debug(ctx context.Context) core.LogEvent {
 	return GetLogger().Debug(ctx)
@@ -289,6 +455,16 @@ func Fatal(ctx context.Context) core.LogEvent {
 	return GetLogger().Fatal(ctx)
 }
 
+// Panic retorna um LogEvent para nível PANIC usando o logger global
+func Panic(ctx context.Context) core.LogEvent {
+	return GetLogger().Panic(ctx)
+}
+
+// Log retorna um LogEvent com o nível especificado usando o logger global
+func Log(ctx context.Context, level core.Level) core.LogEvent {
+	return GetLogger().Log(ctx, level)
+}
+
 // WithContext retorna um novo logger global com contexto
 func WithContext(ctx context.Context) Logger {
 	return GetLogger().WithContext(ctx)
@@ -299,17 +475,52 @@ func WithFields(fields map[string]interface{}) Logger {
 	return GetLogger().WithFields(fields)
 }
 
-// createAdapterFromConfig cria um adapter baseado na configuração
-func createAdapterFromConfig(config Config) (core.LoggerAdapter, error) {
+// Named retorna um novo logger global identificado pelo componente especificado
+func Named(name string) Logger {
+	return GetLogger().Named(name)
+}
+
+// GetAdapter retorna o adapter do logger global, com os decorators de
+// observabilidade, supressão de duplicatas e hooks configurados. Deve ser
+// usado pelos middlewares HTTP e pela integração PGX para que suas
+// entradas passem pelo mesmo pipeline.
+func GetAdapter() core.LoggerAdapter {
+	return GetLogger().(*logger).adapter
+}
+
+// WithHooks retorna um novo logger global que executa os hooks especificados
+func WithHooks(hooks ...core.Hook) Logger {
+	return GetLogger().WithHooks(hooks...)
+}
+
+// createAdapterFromConfig cria um adapter baseado na configuração,
+// retornando também o OutputManager usado pelo adapter base. Os hooks
+// configurados são adicionados ao pipeline, sendo executados após a
+// formatação, e a supressão de duplicatas envolve o adapter resultante.
+func createAdapterFromConfig(config Config) (core.LoggerAdapter, *core.OutputManager, error) {
+	adapter, output, err := createPipelineAdapter(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	adapter = core.WithAdapterHooks(adapter, config.Hooks...)
+	if config.Dedup.Enabled {
+		adapter = core.NewDedupAdapter(adapter, config.Dedup)
+	}
+	return adapter, output, nil
+}
+
+// createPipelineAdapter cria o adapter base e os adapters de observabilidade
+func createPipelineAdapter(config Config) (core.LoggerAdapter, *core.OutputManager, error) {
 	// Criar adapter base (Zerolog)
-	baseAdapter, err := createBaseAdapter(config)
+	baseAdapter, output, err := createBaseAdapter(config)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create base adapter: %w", err)
+		return nil, nil, fmt.Errorf("failed to create base adapter: %w", err)
 	}
 
 	// Se observabilidade está desabilitada, retornar apenas o adapter base
 	if !config.Observability.Enabled {
-		return baseAdapter, nil
+		return baseAdapter, output, nil
 	}
 
 	// Criar adapter de observabilidade baseado no ambiente
@@ -326,19 +537,19 @@ func createAdapterFromConfig(config Config) (core.LoggerAdapter, error) {
 
 	if err != nil {
 		// Se falhar ao criar adapter de observabilidade, usar apenas o base
-		return baseAdapter, nil
+		return baseAdapter, output, nil
 	}
 
-	return observabilityAdapter, nil
+	return observabilityAdapter, output, nil
 }
 
-// createBaseAdapter cria o adapter base (Zerolog) baseado na configuração
-func createBaseAdapter(config Config) (core.LoggerAdapter, error) {
+// createBaseAdapter cria o adapter base (Zerolog) e o OutputManager que
+// fornece o seu writer, baseado na configuração
+func createBaseAdapter(config Config) (core.LoggerAdapter, *core.OutputManager, error) {
 	// Configurar ZerologConfig baseado na Config
 	zerologConfig := &adapters.ZerologConfig{
-		Level:         config.LogLevel,
-		PrettyPrint:   config.PrettyPrint,
-		CallerEnabled: config.CallerEnabled,
+		Levels:      globalLevels,
+		PrettyPrint: config.PrettyPrint,
 	}
 
 	// Configurar saída usando OutputManager
@@ -360,10 +571,12 @@ func createBaseAdapter(config Config) (core.LoggerAdapter, error) {
 		}
 	}
 
+	outputConfig.Async = config.Async
+
 	// Criar OutputManager
 	outputManager, err := core.NewOutputManager(outputConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create output manager: %w", err)
+		return nil, nil, fmt.Errorf("failed to create output manager: %w", err)
 	}
 
 	// Configurar writer baseado no tipo de output
@@ -376,8 +589,19 @@ func createBaseAdapter(config Config) (core.LoggerAdapter, error) {
 	} else {
 		zerologConfig.Writer = os.Stdout // fallback
 	}
+	zerologConfig.Closer = outputManager
+
+	return adapters.NewZerologAdapter(zerologConfig), outputManager, nil
+}
 
-	return adapters.NewZerologAdapter(zerologConfig), nil
+// eventOptions retorna as opções dos eventos de log derivadas da configuração
+func (c Config) eventOptions() core.EventOptions {
+	return core.DefaultEventOptions().
+		WithCaller(c.CallerEnabled).
+		WithCallerSkip(c.CallerSkip).
+		WithStackTrace(c.StackTrace).
+		WithSampler(c.Sampler).
+		WithRateLimit(c.RateLimit)
 }
 
 // String retorna uma representação em string da configuração para debugging
@@ -405,13 +629,35 @@ func (c Config) Validate() error {
 	}
 
 	// Validar nível de log
-	switch c.LogLevel {
-	case core.DEBUG, core.INFO, core.WARN, core.ERROR, core.FATAL:
-		// Níveis válidos
-	default:
+	if !c.LogLevel.IsValid() {
 		return fmt.Errorf("invalid log level: %v", c.LogLevel)
 	}
 
+	for component, level := range c.ComponentLevels {
+		if component == "" {
+			return fmt.Errorf("component name cannot be empty in component levels")
+		}
+		if !level.IsValid() {
+			return fmt.Errorf("invalid log level for component %s: %v", component, level)
+		}
+	}
+
+	if c.StackTrace < core.StackTraceOnError || c.StackTrace > core.StackTraceAlways {
+		return fmt.Errorf("invalid stack trace policy: %d", c.StackTrace)
+	}
+
+	if err := c.Async.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Dedup.Validate(); err != nil {
+		return err
+	}
+
+	if c.RateLimit < 0 {
+		return fmt.Errorf("rate limit cannot be negative, got %v", c.RateLimit)
+	}
+
 	return nil
 }
 
@@ -425,20 +671,99 @@ func getEnv(key, defaultValue string) string {
 
 // parseLogLevel converte uma string para core.Level
 func parseLogLevel(levelStr string) core.Level {
-	switch strings.ToUpper(levelStr) {
-	case "DEBUG":
-		return core.DEBUG
-	case "INFO":
-		return core.INFO
-	case "WARN", "WARNING":
-		return core.WARN
-	case "ERROR":
-		return core.ERROR
-	case "FATAL":
-		return core.FATAL
-	default:
+	level, err := core.ParseLevel(levelStr)
+	if err != nil {
 		return DefaultLogLevel
 	}
+	return level
+}
+
+// parseStackTracePolicy converte uma string para core.StackTracePolicy
+func parseStackTracePolicy(policyStr string) core.StackTracePolicy {
+	policy, err := core.ParseStackTracePolicy(policyStr)
+	if err != nil {
+		return core.StackTraceOnError
+	}
+	return policy
+}
+
+// parseAsyncConfig carrega a configuração da escrita assíncrona das
+// variáveis de ambiente. Valores inválidos usam o padrão.
+func parseAsyncConfig() core.AsyncConfig {
+	config := core.DefaultAsyncConfig().
+		WithEnabled(parseBool(getEnv(EnvAsync, "false")))
+
+	if size, err := strconv.Atoi(getEnv(EnvAsyncBufferSize, "")); err == nil && size > 0 {
+		config = config.WithBufferSize(size)
+	}
+	if policy, err := core.ParseOverflowPolicy(getEnv(EnvAsyncOverflow, "")); err == nil {
+		config = config.WithOverflow(policy)
+	}
+
+	return config
+}
+
+// parseRateLimit converte uma string em limite de entradas por segundo.
+// Valores inválidos ou negativos desabilitam o limite.
+func parseRateLimit(rateStr string) float64 {
+	rate, err := strconv.ParseFloat(strings.TrimSpace(rateStr), 64)
+	if err != nil || rate < 0 {
+		return 0
+	}
+	return rate
+}
+
+// parseDedupConfig carrega a configuração da supressão de duplicatas das
+// variáveis de ambiente. Valores inválidos usam o padrão.
+func parseDedupConfig() core.DedupConfig {
+	config := core.DefaultDedupConfig().
+		WithEnabled(parseBool(getEnv(EnvDedup, "false")))
+
+	if window, err := time.ParseDuration(getEnv(EnvDedupWindow, "")); err == nil && window > 0 {
+		config = config.WithWindow(window)
+	}
+	if fieldsStr := getEnv(EnvDedupFields, ""); fieldsStr != "" {
+		var fields []string
+		for _, field := range strings.Split(fieldsStr, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+		config = config.WithFields(fields...)
+	}
+
+	return config
+}
+
+// parseComponentLevels converte uma string no formato "pgx=warn,http_middleware=debug"
+// para um map de níveis por componente. Entradas inválidas são ignoradas.
+func parseComponentLevels(levelsStr string) map[string]core.Level {
+	levels := make(map[string]core.Level)
+	for _, entry := range strings.Split(levelsStr, ",") {
+		component, levelStr, found := strings.Cut(entry, "=")
+		component = strings.TrimSpace(component)
+		if !found || component == "" {
+			continue
+		}
+		level, err := core.ParseLevel(levelStr)
+		if err != nil {
+			continue
+		}
+		levels[component] = level
+	}
+	return levels
+}
+
+// applyComponentLevels substitui os overrides por componente do registry global
+func applyComponentLevels(levels map[string]core.Level) {
+	for component := range globalLevels.ComponentLevels() {
+		if _, ok := levels[component]; !ok {
+			globalLevels.RemoveComponentLevel(component)
+		}
+	}
+	for component, level := range levels {
+		globalLevels.SetComponentLevel(component, level)
+	}
 }
 
 // parseOutputType converte uma string para OutputType
@@ -2,10 +2,15 @@ package logger
 
 import (
 	"context"
+	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/victorximenis/logger/core"
+	"github.com/victorximenis/logger/observability"
 )
 
 func TestNewConfig(t *testing.T) {
@@ -118,6 +123,30 @@ func TestConfig_Validate(t *testing.T) {
 			expectErr: true,
 			errMsg:    "invalid log level: UNKNOWN",
 		},
+		{
+			name: "invalid component level",
+			config: Config{
+				ServiceName:     "test-service",
+				Environment:     "test",
+				Output:          OutputStdout,
+				LogLevel:        core.INFO,
+				ComponentLevels: map[string]core.Level{"pgx": core.Level(999)},
+			},
+			expectErr: true,
+			errMsg:    "invalid log level for component pgx: UNKNOWN",
+		},
+		{
+			name: "invalid stack trace policy",
+			config: Config{
+				ServiceName: "test-service",
+				Environment: "test",
+				Output:      OutputStdout,
+				LogLevel:    core.INFO,
+				StackTrace:  core.StackTracePolicy(99),
+			},
+			expectErr: true,
+			errMsg:    "invalid stack trace policy: 99",
+		},
 	}
 
 	for _, tt := range tests {
@@ -154,6 +183,8 @@ func TestParseLogLevel(t *testing.T) {
 		{"error", core.ERROR},
 		{"FATAL", core.FATAL},
 		{"fatal", core.FATAL},
+		{"trace", core.TRACE},
+		{"PANIC", core.PANIC},
 		{"invalid", DefaultLogLevel},
 		{"", DefaultLogLevel},
 	}
@@ -168,6 +199,27 @@ func TestParseLogLevel(t *testing.T) {
 	}
 }
 
+func TestParseComponentLevels(t *testing.T) {
+	levels := parseComponentLevels(" pgx=warn, http_middleware=DEBUG,invalid,empty=,=info,bad=verbose")
+
+	expected := map[string]core.Level{
+		"pgx":             core.WARN,
+		"http_middleware": core.DEBUG,
+	}
+	if len(levels) != len(expected) {
+		t.Fatalf("Expected %d component levels, got %v", len(expected), levels)
+	}
+	for component, level := range expected {
+		if levels[component] != level {
+			t.Errorf("Expected %s=%s, got %s", component, level, levels[component])
+		}
+	}
+
+	if len(parseComponentLevels("")) != 0 {
+		t.Error("Expected no component levels for empty string")
+	}
+}
+
 func TestParseOutputType(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -225,6 +277,8 @@ func TestLoadConfigFromEnv(t *testing.T) {
 	envVars := []string{
 		EnvServiceName, EnvEnvironment, EnvOutput, EnvLogLevel,
 		EnvLogFilePath, EnvTenantID, EnvPrettyPrint, EnvCallerEnabled,
+		EnvStackTrace, EnvAsync, EnvAsyncBufferSize, EnvAsyncOverflow,
+		EnvDedup, EnvDedupWindow, EnvDedupFields, EnvRateLimit,
 	}
 
 	for _, env := range envVars {
@@ -263,6 +317,12 @@ func TestLoadConfigFromEnv(t *testing.T) {
 		if config.LogLevel != DefaultLogLevel {
 			t.Errorf("Expected LogLevel %v, got %v", DefaultLogLevel, config.LogLevel)
 		}
+		if config.StackTrace != core.StackTraceOnError {
+			t.Errorf("Expected StackTrace %v, got %v", core.StackTraceOnError, config.StackTrace)
+		}
+		if config.Async != core.DefaultAsyncConfig() {
+			t.Errorf("Expected default Async config, got %+v", config.Async)
+		}
 	})
 
 	// Teste 2: Com variáveis de ambiente customizadas
@@ -275,6 +335,14 @@ func TestLoadConfigFromEnv(t *testing.T) {
 		os.Setenv(EnvTenantID, "tenant-123")
 		os.Setenv(EnvPrettyPrint, "true")
 		os.Setenv(EnvCallerEnabled, "true")
+		os.Setenv(EnvStackTrace, "always")
+		os.Setenv(EnvAsync, "true")
+		os.Setenv(EnvAsyncBufferSize, "128")
+		os.Setenv(EnvAsyncOverflow, "drop_oldest")
+		os.Setenv(EnvDedup, "true")
+		os.Setenv(EnvDedupWindow, "30s")
+		os.Setenv(EnvDedupFields, "component, error")
+		os.Setenv(EnvRateLimit, "25")
 
 		config := LoadConfigFromEnv()
 
@@ -302,6 +370,18 @@ func TestLoadConfigFromEnv(t *testing.T) {
 		if !config.CallerEnabled {
 			t.Errorf("Expected CallerEnabled true, got %t", config.CallerEnabled)
 		}
+		if config.StackTrace != core.StackTraceAlways {
+			t.Errorf("Expected StackTrace %v, got %v", core.StackTraceAlways, config.StackTrace)
+		}
+		if !config.Async.Enabled || config.Async.BufferSize != 128 || config.Async.Overflow != core.OverflowDropOldest {
+			t.Errorf("Expected async enabled with 128 entries and drop_oldest, got %+v", config.Async)
+		}
+		if !config.Dedup.Enabled || config.Dedup.Window != 30*time.Second || strings.Join(config.Dedup.Fields, ",") != "component,error" {
+			t.Errorf("Expected dedup enabled with 30s window and fields component,error, got %+v", config.Dedup)
+		}
+		if config.RateLimit != 25 {
+			t.Errorf("Expected RateLimit 25, got %v", config.RateLimit)
+		}
 	})
 }
 
@@ -530,6 +610,91 @@ func TestGlobalHelpers(t *testing.T) {
 	}
 }
 
+func TestSetLevel(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	ctx := context.Background()
+	if err := Init(NewConfig()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	derived := WithFields(map[string]interface{}{"component": "worker"}).WithContext(ctx)
+	if derived.Debug(ctx).Enabled() {
+		t.Error("Expected DEBUG to be disabled with default level")
+	}
+
+	SetLevel(core.DEBUG)
+	if GetLevel() != core.DEBUG {
+		t.Errorf("Expected level DEBUG, got %s", GetLevel())
+	}
+	if GetConfig().LogLevel != core.DEBUG {
+		t.Errorf("Expected config level DEBUG, got %s", GetConfig().LogLevel)
+	}
+	if !derived.Debug(ctx).Enabled() {
+		t.Error("Expected DEBUG to be enabled on derived logger after SetLevel")
+	}
+
+	SetLevel(core.ERROR)
+	if derived.Warn(ctx).Enabled() {
+		t.Error("Expected WARN to be disabled on derived logger after SetLevel(ERROR)")
+	}
+	if !Error(ctx).Enabled() {
+		t.Error("Expected ERROR to be enabled on global logger")
+	}
+}
+
+func TestNamed_ComponentLevels(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	ctx := context.Background()
+	config := NewConfig()
+	config.ComponentLevels = map[string]core.Level{
+		"pgx":             core.WARN,
+		"http_middleware": core.DEBUG,
+	}
+	if err := Init(config); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if Named("pgx").Info(ctx).Enabled() {
+		t.Error("Expected INFO to be disabled for pgx")
+	}
+	if !Named("http_middleware").Debug(ctx).Enabled() {
+		t.Error("Expected DEBUG to be enabled for http_middleware")
+	}
+	if Named("other").Debug(ctx).Enabled() || Debug(ctx).Enabled() {
+		t.Error("Expected DEBUG to be disabled for loggers without override")
+	}
+
+	// Os overrides não reduzem o nível global do adapter
+	if GetAdapter().IsLevelEnabled(core.DEBUG) {
+		t.Error("Expected the adapter level to remain INFO with a DEBUG override")
+	}
+	handler := NewGlobalSlogHandler()
+	if handler.Enabled(ctx, slog.LevelDebug) {
+		t.Error("Expected slog DEBUG to be disabled without component")
+	}
+	if !handler.WithAttrs([]slog.Attr{slog.String("component", "http_middleware")}).Enabled(ctx, slog.LevelDebug) {
+		t.Error("Expected slog DEBUG to be enabled for http_middleware")
+	}
+
+	// Overrides alterados em tempo de execução têm efeito imediato
+	Levels().SetComponentLevel("pgx", core.DEBUG)
+	if !Named("pgx").WithFields(map[string]interface{}{"db": "main"}).Debug(ctx).Enabled() {
+		t.Error("Expected DEBUG to be enabled for pgx after runtime override")
+	}
+
+	// Reinicializar substitui os overrides anteriores
+	if err := Init(NewConfig()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(Levels().ComponentLevels()) != 0 {
+		t.Errorf("Expected overrides to be cleared after Init, got %v", Levels().ComponentLevels())
+	}
+}
+
 func TestCreateAdapterFromConfig(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -572,7 +737,7 @@ func TestCreateAdapterFromConfig(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			adapter, err := createAdapterFromConfig(tt.config)
+			adapter, _, err := createAdapterFromConfig(tt.config)
 			if tt.expectErr {
 				if err == nil {
 					t.Error("Expected error but got none")
@@ -589,6 +754,170 @@ func TestCreateAdapterFromConfig(t *testing.T) {
 	}
 }
 
+func TestCreateAdapterFromConfig_Hooks(t *testing.T) {
+	var seen map[string]interface{}
+	config := NewConfig()
+	config.Output = OutputFile
+	config.LogFilePath = filepath.Join(t.TempDir(), "hooks.log")
+	config.Hooks = []core.Hook{core.HookFunc(func(entry *core.Entry) bool {
+		seen = entry.Fields
+		return true
+	})}
+
+	adapter, _, err := createAdapterFromConfig(config)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %v", err)
+	}
+	defer core.CloseAdapter(adapter)
+
+	adapter.Log(context.Background(), core.INFO, "hooked", map[string]interface{}{"key": "value"})
+
+	// Os hooks devem receber os campos base e os do enriquecimento
+	if seen["env"] != config.Environment {
+		t.Errorf("Expected hooks to see the formatted env field, got %v", seen)
+	}
+	if seen["key"] != "value" {
+		t.Errorf("Expected hooks to see the event fields, got %v", seen)
+	}
+	if _, ok := seen["hostname"]; !ok {
+		t.Errorf("Expected hooks to see the ELK enrichment fields, got %v", seen)
+	}
+}
+
+func TestAsyncStats(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	if stats := AsyncStats(); stats != (core.AsyncStats{}) {
+		t.Errorf("Expected zero stats before Init, got %+v", stats)
+	}
+
+	config := NewConfig()
+	config.Output = OutputFile
+	config.LogFilePath = filepath.Join(t.TempDir(), "async.log")
+	config.Observability.Enabled = false
+	config.Async = core.DefaultAsyncConfig().WithEnabled(true)
+	if err := Init(config); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	ctx := context.Background()
+	Info(ctx).Msg("queued")
+	if err := Flush(ctx); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if stats := AsyncStats(); stats.Written != 1 || stats.Dropped != 0 || stats.Pending != 0 {
+		t.Errorf("Expected 1 written entry, got %+v", stats)
+	}
+
+	if err := Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown failed: %v", err)
+	}
+	if stats := AsyncStats(); stats != (core.AsyncStats{}) {
+		t.Errorf("Expected zero stats after Shutdown, got %+v", stats)
+	}
+}
+
+func TestGetAdapter_Dedup(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	config := NewConfig()
+	config.Dedup = core.DefaultDedupConfig().WithEnabled(true)
+	if err := Init(config); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	if _, ok := GetAdapter().(*core.DedupAdapter); !ok {
+		t.Errorf("Expected global adapter to be *core.DedupAdapter, got %T", GetAdapter())
+	}
+	if err := Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown failed: %v", err)
+	}
+}
+
+func TestShutdown(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	dir := t.TempDir()
+	newFileConfig := func(name string) Config {
+		config := NewConfig()
+		config.Output = OutputFile
+		config.LogFilePath = filepath.Join(dir, name)
+		config.Observability.Enabled = false
+		return config
+	}
+
+	ctx := context.Background()
+	if err := Init(newFileConfig("first.log")); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	Info(ctx).Msg("first pipeline")
+
+	// Reinicializar descarta o pipeline anterior
+	if err := Init(newFileConfig("second.log")); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	Info(ctx).Msg("second pipeline")
+
+	if err := Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if IsInitialized() {
+		t.Error("Expected logger not to be initialized after Shutdown")
+	}
+	if err := Shutdown(ctx); err != nil {
+		t.Errorf("Expected repeated Shutdown to be a no-op, got %v", err)
+	}
+
+	for name, msg := range map[string]string{"first.log": "first pipeline", "second.log": "second pipeline"} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+		if !strings.Contains(string(content), msg) {
+			t.Errorf("Expected %s to contain %q, got %q", name, msg, content)
+		}
+	}
+}
+
+func TestInit_ReinitKeepsDatadog(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	// Ambientes fora de production/development usam config.Observability
+	config := NewConfig()
+	config.Environment = "test"
+	config.Output = OutputStdout
+	config.Observability.Enabled = true
+	config.Observability.EnableDatadog = true
+	config.Observability.FallbackOnError = false
+	config.Observability.Datadog = observability.DatadogConfig{
+		Enabled:        true,
+		AgentHost:      "127.0.0.1:8125",
+		ServiceName:    "test-service",
+		Environment:    "test",
+		MetricsEnabled: true,
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := Init(config); err != nil {
+			t.Fatalf("Init %d failed: %v", i+1, err)
+		}
+	}
+	if !observability.DatadogRunning() {
+		t.Error("Expected Datadog to keep running after re-initialization")
+	}
+
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	if observability.DatadogRunning() {
+		t.Error("Expected Shutdown to stop Datadog")
+	}
+}
+
 // resetGlobalState reseta o estado global para testes
 func resetGlobalState() {
 	initMutex.Lock()
@@ -596,5 +925,10 @@ func resetGlobalState() {
 
 	defaultLogger = nil
 	defaultConfig = Config{}
+	defaultOutput = nil
 	isInitialized = false
+	globalLevel.SetLevel(DefaultLogLevel)
+	for component := range globalLevels.ComponentLevels() {
+		globalLevels.RemoveComponentLevel(component)
+	}
 }
@@ -0,0 +1,28 @@
+package logger
+
+import "context"
+
+// loggerContextKey é a chave do logger armazenado no contexto
+type loggerContextKey struct{}
+
+// IntoContext retorna uma cópia do contexto contendo o logger especificado,
+// recuperável via FromContext. Os middlewares HTTP armazenam por esse meio
+// um logger da requisição com request_id, method e route pré-definidos.
+func IntoContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, l)
+}
+
+// FromContext retorna o logger armazenado no contexto via IntoContext ou,
+// na ausência, o logger global.
+//
+// Exemplo:
+//
+//	logger.FromContext(ctx).Info(ctx).Msg("Order created")
+func FromContext(ctx context.Context) Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(loggerContextKey{}).(Logger); ok && l != nil {
+			return l
+		}
+	}
+	return GetLogger()
+}
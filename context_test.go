@@ -0,0 +1,25 @@
+package logger
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	if FromContext(context.Background()) != GetLogger() {
+		t.Error("Expected FromContext to fall back to the global logger")
+	}
+
+	adapter := &mockAdapter{}
+	requestLogger := New(adapter).WithFields(map[string]interface{}{"request_id": "req-1"})
+	ctx := IntoContext(context.Background(), requestLogger)
+
+	FromContext(ctx).Info(ctx).Msg("handled")
+
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+	if adapter.logCalls[0].fields["request_id"] != "req-1" {
+		t.Errorf("Expected request logger fields, got %v", adapter.logCalls[0].fields)
+	}
+}
@@ -2,25 +2,36 @@ package core
 
 import "context"
 
-// Level representa os níveis de log disponíveis
+// Level representa os níveis de log disponíveis. Os níveis são espaçados
+// para permitir o registro de níveis customizados intermediários via
+// RegisterLevel (ex: NOTICE entre INFO e WARN). O valor zero é INFO, um
+// nível válido, e não indica ausência de configuração. Em versões
+// anteriores os níveis eram sequenciais a partir de DEBUG=0; valores
+// numéricos persistidos devem ser convertidos pelo nome (ParseLevel).
 type Level int
 
 const (
+	// TRACE representa o nível de rastreamento, mais detalhado que DEBUG
+	TRACE Level = -8
 	// DEBUG representa o nível de debug para informações detalhadas de depuração
-	DEBUG Level = iota
+	DEBUG Level = -4
 	// INFO representa o nível de informação para mensagens informativas gerais
-	INFO
+	INFO Level = 0
 	// WARN representa o nível de aviso para situações que merecem atenção
-	WARN
+	WARN Level = 4
 	// ERROR representa o nível de erro para erros que não impedem a execução
-	ERROR
+	ERROR Level = 8
 	// FATAL representa o nível fatal para erros críticos que impedem a execução
-	FATAL
+	FATAL Level = 12
+	// PANIC representa o nível que registra a entrada e em seguida dispara um panic
+	PANIC Level = 16
 )
 
 // String retorna a representação em string do nível de log
 func (l Level) String() string {
 	switch l {
+	case TRACE:
+		return "TRACE"
 	case DEBUG:
 		return "DEBUG"
 	case INFO:
@@ -31,7 +42,12 @@ func (l Level) String() string {
 		return "ERROR"
 	case FATAL:
 		return "FATAL"
+	case PANIC:
+		return "PANIC"
 	default:
+		if name, ok := customLevelName(l); ok {
+			return name
+		}
 		return "UNKNOWN"
 	}
 }
@@ -44,6 +60,8 @@ type LoggerAdapter interface {
 	// Log registra uma mensagem com o nível especificado, contexto e campos adicionais.
 	// O contexto pode conter informações como trace ID, user ID, etc.
 	// Os campos permitem adicionar metadados estruturados à entrada de log.
+	// Entradas FATAL e PANIC são apenas registradas: o encerramento via Exit
+	// e o panic são feitos pelo LogEvent após o envio, não pelo adapter.
 	Log(ctx context.Context, level Level, msg string, fields map[string]interface{})
 
 	// WithContext retorna uma nova instância do adapter com o contexto especificado.
@@ -55,3 +73,33 @@ type LoggerAdapter interface {
 	// que não serão registrados.
 	IsLevelEnabled(level Level) bool
 }
+
+// TypedLoggerAdapter é uma extensão opcional de LoggerAdapter para adapters
+// capazes de consumir campos tipados diretamente, sem a conversão para
+// map[string]interface{}. Quando o adapter implementa esta interface,
+// o LogEvent envia seus campos através de LogTyped.
+type TypedLoggerAdapter interface {
+	LoggerAdapter
+
+	// LogTyped registra uma mensagem com campos tipados. O slice de campos
+	// pertence ao chamador e não deve ser retido após o retorno do método.
+	LogTyped(ctx context.Context, level Level, msg string, fields []Field)
+}
+
+// ComponentLevelEnabler é uma extensão opcional de LoggerAdapter para adapters
+// que suportam níveis mínimos por componente. Decorators devem encaminhar a
+// chamada para o adapter decorado.
+type ComponentLevelEnabler interface {
+	// IsComponentLevelEnabled verifica se o nível está habilitado para o componente
+	IsComponentLevelEnabled(component string, level Level) bool
+}
+
+// IsComponentLevelEnabled verifica se o nível está habilitado para o componente
+// no adapter especificado. Adapters que não implementam ComponentLevelEnabler
+// usam IsLevelEnabled.
+func IsComponentLevelEnabled(adapter LoggerAdapter, component string, level Level) bool {
+	if c, ok := adapter.(ComponentLevelEnabler); ok {
+		return c.IsComponentLevelEnabled(component, level)
+	}
+	return adapter.IsLevelEnabled(level)
+}
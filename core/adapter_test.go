@@ -62,11 +62,13 @@ func TestLevel_String(t *testing.T) {
 		level    Level
 		expected string
 	}{
+		{"TRACE level", TRACE, "TRACE"},
 		{"DEBUG level", DEBUG, "DEBUG"},
 		{"INFO level", INFO, "INFO"},
 		{"WARN level", WARN, "WARN"},
 		{"ERROR level", ERROR, "ERROR"},
 		{"FATAL level", FATAL, "FATAL"},
+		{"PANIC level", PANIC, "PANIC"},
 		{"Unknown level", Level(999), "UNKNOWN"},
 	}
 
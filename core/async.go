@@ -0,0 +1,386 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OverflowPolicy define o comportamento do AsyncWriter quando a fila está cheia
+type OverflowPolicy int
+
+const (
+	// OverflowBlock bloqueia a chamada de log até haver espaço na fila
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest descarta a entrada sendo escrita
+	OverflowDropNewest
+	// OverflowDropOldest descarta a entrada mais antiga da fila
+	OverflowDropOldest
+)
+
+// String retorna a representação em string da política
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowBlock:
+		return "block"
+	case OverflowDropNewest:
+		return "drop_newest"
+	case OverflowDropOldest:
+		return "drop_oldest"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseOverflowPolicy converte uma string ("block", "drop_newest" ou
+// "drop_oldest") em OverflowPolicy
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(policy)) {
+	case "block":
+		return OverflowBlock, nil
+	case "drop_newest", "drop-newest":
+		return OverflowDropNewest, nil
+	case "drop_oldest", "drop-oldest":
+		return OverflowDropOldest, nil
+	default:
+		return OverflowBlock, fmt.Errorf("invalid overflow policy: %s", policy)
+	}
+}
+
+// DefaultAsyncBufferSize é a capacidade padrão da fila do AsyncWriter
+const DefaultAsyncBufferSize = 4096
+
+// AsyncConfig define a configuração da escrita assíncrona
+type AsyncConfig struct {
+	// Enabled habilita a escrita assíncrona
+	Enabled bool
+	// BufferSize é a capacidade da fila, em entradas (padrão: 4096)
+	BufferSize int
+	// Overflow define o comportamento com a fila cheia (padrão: OverflowDropNewest)
+	Overflow OverflowPolicy
+	// ExemptErrors impede o descarte de entradas ERROR ou superiores, que
+	// aguardam espaço na fila mesmo com políticas de descarte (padrão: true)
+	ExemptErrors bool
+}
+
+// DefaultAsyncConfig retorna a configuração padrão da escrita assíncrona,
+// desabilitada até que WithEnabled(true) seja usado
+func DefaultAsyncConfig() AsyncConfig {
+	return AsyncConfig{
+		Enabled:      false,
+		BufferSize:   DefaultAsyncBufferSize,
+		Overflow:     OverflowDropNewest,
+		ExemptErrors: true,
+	}
+}
+
+// WithEnabled habilita ou desabilita a escrita assíncrona
+func (c AsyncConfig) WithEnabled(enabled bool) AsyncConfig {
+	c.Enabled = enabled
+	return c
+}
+
+// WithBufferSize configura a capacidade da fila
+func (c AsyncConfig) WithBufferSize(size int) AsyncConfig {
+	c.BufferSize = size
+	return c
+}
+
+// WithOverflow configura o comportamento com a fila cheia
+func (c AsyncConfig) WithOverflow(policy OverflowPolicy) AsyncConfig {
+	c.Overflow = policy
+	return c
+}
+
+// WithExemptErrors configura se entradas ERROR ou superiores podem ser descartadas
+func (c AsyncConfig) WithExemptErrors(exempt bool) AsyncConfig {
+	c.ExemptErrors = exempt
+	return c
+}
+
+// Validate verifica se a configuração é válida
+func (c AsyncConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.BufferSize <= 0 {
+		return fmt.Errorf("async buffer size must be positive, got %d", c.BufferSize)
+	}
+	if c.Overflow < OverflowBlock || c.Overflow > OverflowDropOldest {
+		return fmt.Errorf("invalid overflow policy: %d", c.Overflow)
+	}
+	return nil
+}
+
+// LevelWriter é implementado por writers que tratam as entradas de acordo
+// com o nível, como o AsyncWriter
+type LevelWriter interface {
+	io.Writer
+	WriteLevel(level Level, p []byte) (n int, err error)
+}
+
+// AsyncStats contém os contadores do AsyncWriter
+type AsyncStats struct {
+	// Written é o número de entradas escritas no destino
+	Written uint64
+	// Dropped é o número de entradas descartadas por overflow
+	Dropped uint64
+	// Pending é o número de entradas aguardando escrita
+	Pending int
+}
+
+// asyncEntry é uma entrada na fila do AsyncWriter
+type asyncEntry struct {
+	level Level
+	data  []byte
+}
+
+// AsyncWriter desacopla as chamadas de log da escrita no destino através de
+// uma fila circular limitada, esvaziada por uma goroutine em background.
+// Quando entradas são descartadas, uma linha de resumo é escrita no destino
+// com a quantidade descartada.
+type AsyncWriter struct {
+	out    io.Writer
+	config AsyncConfig
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	ring     []asyncEntry
+	head     int
+	count    int
+	closed   bool
+	// enqueued e completed contam as entradas enfileiradas e as já escritas
+	// ou descartadas da fila, permitindo ao Flush aguardar apenas as
+	// entradas enfileiradas até a sua chamada
+	enqueued  uint64
+	completed uint64
+	// progress é fechado e substituído sempre que completed avança
+	progress chan struct{}
+
+	written uint64
+	dropped uint64
+	// pendingDrops contém os descartes ainda não reportados na linha de resumo
+	pendingDrops uint64
+
+	done               chan struct{}
+	unregisterShutdown func()
+}
+
+// NewAsyncWriter cria um AsyncWriter que escreve em out e inicia a
+// goroutine de escrita. Close deve ser chamado para esvaziar a fila e
+// encerrar a goroutine; o destino out não é fechado. O campo Enabled da
+// configuração é ignorado.
+func NewAsyncWriter(out io.Writer, config AsyncConfig) *AsyncWriter {
+	if config.BufferSize <= 0 {
+		config.BufferSize = DefaultAsyncBufferSize
+	}
+
+	w := &AsyncWriter{
+		out:      out,
+		config:   config,
+		ring:     make([]asyncEntry, config.BufferSize),
+		progress: make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	w.notEmpty = sync.NewCond(&w.mu)
+	w.notFull = sync.NewCond(&w.mu)
+
+	// Garantir que a fila seja esvaziada antes do encerramento via Exit
+	w.unregisterShutdown = OnShutdown("async", w.Flush)
+
+	go w.run()
+	return w
+}
+
+// Write implementa a interface io.Writer. Entradas sem nível são tratadas
+// como INFO.
+func (w *AsyncWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(INFO, p)
+}
+
+// WriteLevel implementa a interface LevelWriter, enfileirando uma cópia de p
+func (w *AsyncWriter) WriteLevel(level Level, p []byte) (int, error) {
+	w.mu.Lock()
+
+	for !w.closed && w.count == len(w.ring) {
+		exempt := w.config.ExemptErrors && level >= ERROR
+		if w.config.Overflow == OverflowDropNewest && !exempt {
+			w.drop()
+			w.mu.Unlock()
+			return len(p), nil
+		}
+		if w.config.Overflow == OverflowDropOldest && w.dropOldest() {
+			break
+		}
+		w.notFull.Wait()
+	}
+
+	if w.closed {
+		// Após o Close as entradas são escritas diretamente no destino
+		w.mu.Unlock()
+		return w.out.Write(p)
+	}
+
+	data := make([]byte, len(p))
+	copy(data, p)
+	w.ring[(w.head+w.count)%len(w.ring)] = asyncEntry{level: level, data: data}
+	w.count++
+	w.enqueued++
+	w.notEmpty.Signal()
+	w.mu.Unlock()
+
+	return len(p), nil
+}
+
+// drop contabiliza uma entrada descartada. Deve ser chamado com mu travado.
+func (w *AsyncWriter) drop() {
+	w.dropped++
+	w.pendingDrops++
+}
+
+// dropOldest descarta a entrada mais antiga que pode ser descartada,
+// preservando entradas isentas. Retorna false se todas as entradas da fila
+// forem isentas. Deve ser chamado com mu travado.
+func (w *AsyncWriter) dropOldest() bool {
+	size := len(w.ring)
+	for i := 0; i < w.count; i++ {
+		idx := (w.head + i) % size
+		if w.config.ExemptErrors && w.ring[idx].level >= ERROR {
+			continue
+		}
+		// Deslocar as entradas anteriores para ocupar a posição removida
+		for j := i; j > 0; j-- {
+			w.ring[(w.head+j)%size] = w.ring[(w.head+j-1)%size]
+		}
+		w.ring[w.head] = asyncEntry{}
+		w.head = (w.head + 1) % size
+		w.count--
+		w.drop()
+		w.complete(1)
+		return true
+	}
+	return false
+}
+
+// run esvazia a fila em lotes até o Close
+func (w *AsyncWriter) run() {
+	defer close(w.done)
+
+	batch := make([]asyncEntry, 0, len(w.ring))
+	for {
+		w.mu.Lock()
+		for w.count == 0 && !w.closed {
+			w.notEmpty.Wait()
+		}
+		if w.count == 0 && w.closed {
+			w.mu.Unlock()
+			return
+		}
+
+		batch = batch[:0]
+		for w.count > 0 {
+			batch = append(batch, w.ring[w.head])
+			w.ring[w.head] = asyncEntry{}
+			w.head = (w.head + 1) % len(w.ring)
+			w.count--
+		}
+		dropped, total := w.pendingDrops, w.dropped
+		w.pendingDrops = 0
+		w.notFull.Broadcast()
+		w.mu.Unlock()
+
+		for _, entry := range batch {
+			_, _ = w.out.Write(entry.data)
+		}
+		if dropped > 0 {
+			_, _ = w.out.Write(dropSummary(dropped, total))
+		}
+
+		w.mu.Lock()
+		w.written += uint64(len(batch))
+		w.complete(len(batch))
+		w.mu.Unlock()
+	}
+}
+
+// complete contabiliza n entradas removidas da fila e acorda os Flush em
+// espera. Deve ser chamado com mu travado.
+func (w *AsyncWriter) complete(n int) {
+	w.completed += uint64(n)
+	close(w.progress)
+	w.progress = make(chan struct{})
+}
+
+// dropSummary formata a linha de resumo dos descartes em JSON
+func dropSummary(dropped, total uint64) []byte {
+	line := make([]byte, 0, 160)
+	line = append(line, `{"level":"WARN","timestamp":"`...)
+	line = append(line, time.Now().UTC().Format(time.RFC3339)...)
+	line = append(line, `","message":"Async log writer dropped entries","dropped":`...)
+	line = strconv.AppendUint(line, dropped, 10)
+	line = append(line, `,"dropped_total":`...)
+	line = strconv.AppendUint(line, total, 10)
+	line = append(line, "}\n"...)
+	return line
+}
+
+// Flush implementa a interface Flusher, aguardando a escrita (ou o
+// descarte por overflow) das entradas enfileiradas até a chamada ou o
+// cancelamento de ctx. Entradas enfileiradas durante a espera não a
+// prolongam.
+func (w *AsyncWriter) Flush(ctx context.Context) error {
+	w.mu.Lock()
+	target := w.enqueued
+	for w.completed < target {
+		progress := w.progress
+		w.mu.Unlock()
+
+		select {
+		case <-progress:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		w.mu.Lock()
+	}
+	w.mu.Unlock()
+	return nil
+}
+
+// Close esvazia a fila e encerra a goroutine de escrita. Entradas escritas
+// após o Close vão diretamente para o destino.
+func (w *AsyncWriter) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	w.notEmpty.Broadcast()
+	w.notFull.Broadcast()
+	w.mu.Unlock()
+
+	<-w.done
+	w.unregisterShutdown()
+	return nil
+}
+
+// Stats retorna os contadores de entradas escritas, descartadas e pendentes
+func (w *AsyncWriter) Stats() AsyncStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return AsyncStats{
+		Written: w.written,
+		Dropped: w.dropped,
+		Pending: w.count,
+	}
+}
+
+// Dropped retorna o número total de entradas descartadas por overflow
+func (w *AsyncWriter) Dropped() uint64 {
+	return w.Stats().Dropped
+}
@@ -0,0 +1,256 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// gatedWriter bloqueia a escrita até que release seja fechado, simulando um
+// destino lento
+type gatedWriter struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	started chan struct{}
+	release chan struct{}
+	once    sync.Once
+}
+
+func newGatedWriter() *gatedWriter {
+	return &gatedWriter{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+}
+
+func (w *gatedWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.started) })
+	<-w.release
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.buf.Write(p)
+}
+
+func (w *gatedWriter) lines() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return strings.Split(strings.TrimSpace(w.buf.String()), "\n")
+}
+
+func TestAsyncWriter_WritesInOrder(t *testing.T) {
+	out := newGatedWriter()
+	close(out.release)
+
+	w := NewAsyncWriter(out, DefaultAsyncConfig().WithBufferSize(8))
+	defer w.Close()
+
+	for _, line := range []string{"a", "b", "c"} {
+		if n, err := w.Write([]byte(line + "\n")); err != nil || n != 2 {
+			t.Fatalf("Write returned (%d, %v)", n, err)
+		}
+	}
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	if got := strings.Join(out.lines(), ","); got != "a,b,c" {
+		t.Errorf("Expected entries in order, got %q", got)
+	}
+	if stats := w.Stats(); stats.Written != 3 || stats.Dropped != 0 || stats.Pending != 0 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+func TestAsyncWriter_DropNewest(t *testing.T) {
+	out := newGatedWriter()
+	w := NewAsyncWriter(out, DefaultAsyncConfig().WithBufferSize(2))
+	defer w.Close()
+
+	// A primeira entrada é retirada da fila e fica bloqueada no destino
+	w.WriteLevel(INFO, []byte("in-flight\n"))
+	<-out.started
+
+	w.WriteLevel(INFO, []byte("queued-1\n"))
+	w.WriteLevel(INFO, []byte("queued-2\n"))
+	w.WriteLevel(INFO, []byte("dropped-1\n"))
+	w.WriteLevel(WARN, []byte("dropped-2\n"))
+
+	// Entradas ERROR são isentas e aguardam espaço na fila
+	written := make(chan struct{})
+	go func() {
+		w.WriteLevel(ERROR, []byte("error\n"))
+		close(written)
+	}()
+
+	if dropped := w.Dropped(); dropped != 2 {
+		t.Errorf("Expected 2 dropped entries, got %d", dropped)
+	}
+
+	close(out.release)
+	select {
+	case <-written:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected exempt ERROR entry to be enqueued after the queue drained")
+	}
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	output := strings.Join(out.lines(), "\n")
+	for _, expected := range []string{"in-flight", "queued-1", "queued-2", "error", `"dropped":2`} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "dropped-1") || strings.Contains(output, "dropped-2") {
+		t.Errorf("Expected newest entries to be dropped, got:\n%s", output)
+	}
+}
+
+func TestAsyncWriter_DropOldest(t *testing.T) {
+	out := newGatedWriter()
+	config := DefaultAsyncConfig().WithBufferSize(2).WithOverflow(OverflowDropOldest)
+	w := NewAsyncWriter(out, config)
+	defer w.Close()
+
+	w.WriteLevel(INFO, []byte("in-flight\n"))
+	<-out.started
+
+	w.WriteLevel(ERROR, []byte("old-error\n"))
+	w.WriteLevel(INFO, []byte("old-info\n"))
+	w.WriteLevel(INFO, []byte("new-info\n"))
+
+	close(out.release)
+	if err := w.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	lines := out.lines()
+	expected := []string{"in-flight", "old-error", "new-info"}
+	for i, line := range expected {
+		if i >= len(lines) || lines[i] != line {
+			t.Fatalf("Expected %v followed by the drop summary, got %v", expected, lines)
+		}
+	}
+	if w.Dropped() != 1 {
+		t.Errorf("Expected 1 dropped entry, got %d", w.Dropped())
+	}
+}
+
+func TestAsyncWriter_FlushHonorsContext(t *testing.T) {
+	out := newGatedWriter()
+	w := NewAsyncWriter(out, DefaultAsyncConfig().WithBufferSize(2))
+
+	w.Write([]byte("blocked\n"))
+	<-out.started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := w.Flush(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+
+	close(out.release)
+	w.Close()
+
+	// Após o Close as entradas são escritas diretamente
+	w.Write([]byte("after-close\n"))
+	if lines := out.lines(); lines[len(lines)-1] != "after-close" {
+		t.Errorf("Expected direct write after Close, got %v", lines)
+	}
+}
+
+// stepWriter libera uma escrita por valor recebido em step
+type stepWriter struct {
+	started chan string
+	step    chan struct{}
+}
+
+func (w *stepWriter) Write(p []byte) (int, error) {
+	w.started <- string(p)
+	<-w.step
+	return len(p), nil
+}
+
+func TestAsyncWriter_FlushWaitsOnlyForQueuedEntries(t *testing.T) {
+	out := &stepWriter{started: make(chan string, 2), step: make(chan struct{})}
+	w := NewAsyncWriter(out, DefaultAsyncConfig().WithBufferSize(4))
+
+	w.Write([]byte("first\n"))
+	<-out.started
+
+	flushed := make(chan error, 1)
+	go func() { flushed <- w.Flush(context.Background()) }()
+	time.Sleep(20 * time.Millisecond)
+
+	// Entradas enfileiradas após a chamada não prolongam o Flush
+	w.Write([]byte("second\n"))
+	out.step <- struct{}{}
+
+	select {
+	case err := <-flushed:
+		if err != nil {
+			t.Errorf("Flush failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected Flush to return once the entries queued before it were written")
+	}
+
+	<-out.started
+	out.step <- struct{}{}
+	w.Close()
+
+	if stats := w.Stats(); stats.Written != 2 || stats.Pending != 0 {
+		t.Errorf("Expected 2 written entries, got %+v", stats)
+	}
+}
+
+func TestOutputManager_Async(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "async.log")
+	config := NewOutputConfig(path)
+	config.Async = DefaultAsyncConfig().WithEnabled(true)
+
+	om, err := NewOutputManager(config)
+	if err != nil {
+		t.Fatalf("NewOutputManager failed: %v", err)
+	}
+
+	writer := om.GetWriter()
+	if _, ok := writer.(*AsyncWriter); !ok {
+		t.Fatalf("Expected *AsyncWriter, got %T", writer)
+	}
+	if om.GetWriter() != writer {
+		t.Error("Expected GetWriter to reuse the async writer")
+	}
+
+	writer.Write([]byte("async entry\n"))
+	if err := om.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if stats := om.AsyncStats(); stats.Written != 1 || stats.Dropped != 0 {
+		t.Errorf("Expected 1 written entry in AsyncStats, got %+v", stats)
+	}
+	if err := om.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if string(content) != "async entry\n" {
+		t.Errorf("Expected queued entry to be written on Close, got %q", content)
+	}
+
+	invalid := NewOutputConfig(path)
+	invalid.Async = DefaultAsyncConfig().WithEnabled(true).WithBufferSize(0)
+	if _, err := NewOutputManager(invalid); err == nil {
+		t.Error("Expected error for async buffer size 0")
+	}
+}
@@ -1,6 +1,9 @@
 package core
 
-import "context"
+import (
+	"context"
+	"sort"
+)
 
 // contextKey é um tipo personalizado para chaves de contexto para evitar colisões
 type contextKey string
@@ -8,8 +11,12 @@ type contextKey string
 // Constantes para chaves de contexto padrão
 const (
 	traceIDKey       contextKey = "trace_id"
+	spanIDKey        contextKey = "span_id"
 	correlationIDKey contextKey = "correlation_id"
+	requestIDKey     contextKey = "request_id"
 	userIDKey        contextKey = "user_id"
+	sessionIDKey     contextKey = "session_id"
+	baggageKey       contextKey = "baggage"
 )
 
 // WithTraceID adiciona um trace ID ao contexto
@@ -17,30 +24,113 @@ func WithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, traceIDKey, traceID)
 }
 
+// WithSpanID adiciona um span ID ao contexto
+func WithSpanID(ctx context.Context, spanID string) context.Context {
+	return context.WithValue(ctx, spanIDKey, spanID)
+}
+
 // WithCorrelationID adiciona um correlation ID ao contexto
 func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
 	return context.WithValue(ctx, correlationIDKey, correlationID)
 }
 
+// WithRequestID adiciona um request ID ao contexto
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
 // WithUserID adiciona um user ID ao contexto
 func WithUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, userIDKey, userID)
 }
 
+// WithSessionID adiciona um session ID ao contexto
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey, sessionID)
+}
+
 // GetTraceID extrai o trace ID do contexto
 func GetTraceID(ctx context.Context) (string, bool) {
-	traceID, ok := ctx.Value(traceIDKey).(string)
-	return traceID, ok && traceID != ""
+	return getContextString(ctx, traceIDKey, "trace_id")
+}
+
+// GetSpanID extrai o span ID do contexto
+func GetSpanID(ctx context.Context) (string, bool) {
+	return getContextString(ctx, spanIDKey, "span_id")
 }
 
 // GetCorrelationID extrai o correlation ID do contexto
 func GetCorrelationID(ctx context.Context) (string, bool) {
-	correlationID, ok := ctx.Value(correlationIDKey).(string)
-	return correlationID, ok && correlationID != ""
+	return getContextString(ctx, correlationIDKey, "correlation_id")
+}
+
+// GetRequestID extrai o request ID do contexto
+func GetRequestID(ctx context.Context) (string, bool) {
+	return getContextString(ctx, requestIDKey, "request_id")
 }
 
 // GetUserID extrai o user ID do contexto
 func GetUserID(ctx context.Context) (string, bool) {
-	userID, ok := ctx.Value(userIDKey).(string)
-	return userID, ok && userID != ""
+	return getContextString(ctx, userIDKey, "user_id")
+}
+
+// GetSessionID extrai o session ID do contexto
+func GetSessionID(ctx context.Context) (string, bool) {
+	return getContextString(ctx, sessionIDKey, "session_id")
+}
+
+// getContextString lê o valor armazenado sob a chave tipada. Por
+// compatibilidade, valores armazenados sob a chave string de mesmo nome
+// (ex: context.WithValue(ctx, "correlation_id", id)) também são lidos; esse
+// uso está deprecated e deve ser substituído pelas funções With*.
+//
+// As chaves são recebidas como interface{} para que as constantes dos
+// chamadores sejam convertidas sem alocação, já que os extractors padrão
+// executam esta função em toda entrada de log.
+func getContextString(ctx context.Context, key, legacyKey interface{}) (string, bool) {
+	if val, ok := ctx.Value(key).(string); ok && val != "" {
+		return val, true
+	}
+	val, ok := ctx.Value(legacyKey).(string)
+	return val, ok && val != ""
+}
+
+// WithBaggage adiciona um item de baggage ao contexto. Os itens de baggage
+// são propagados aos contextos derivados e adicionados como campos a todas
+// as entradas de log, sem que seja necessário registrar um extractor.
+func WithBaggage(ctx context.Context, key, value string) context.Context {
+	current, _ := ctx.Value(baggageKey).(map[string]string)
+	baggage := make(map[string]string, len(current)+1)
+	for k, v := range current {
+		baggage[k] = v
+	}
+	baggage[key] = value
+	return context.WithValue(ctx, baggageKey, baggage)
+}
+
+// GetBaggage retorna o valor do item de baggage especificado
+func GetBaggage(ctx context.Context, key string) (string, bool) {
+	baggage, _ := ctx.Value(baggageKey).(map[string]string)
+	val, ok := baggage[key]
+	return val, ok
+}
+
+// Baggage retorna uma cópia dos itens de baggage do contexto
+func Baggage(ctx context.Context) map[string]string {
+	baggage, _ := ctx.Value(baggageKey).(map[string]string)
+	copied := make(map[string]string, len(baggage))
+	for k, v := range baggage {
+		copied[k] = v
+	}
+	return copied
+}
+
+// baggageKeys retorna as chaves dos itens de baggage em ordem alfabética
+func baggageKeys(baggage map[string]string) []string {
+	keys := make([]string, 0, len(baggage))
+	for k := range baggage {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
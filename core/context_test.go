@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestContextIDs(t *testing.T) {
+	ctx := context.Background()
+	ctx = WithTraceID(ctx, "trace")
+	ctx = WithSpanID(ctx, "span")
+	ctx = WithCorrelationID(ctx, "corr")
+	ctx = WithRequestID(ctx, "req")
+	ctx = WithUserID(ctx, "user")
+	ctx = WithSessionID(ctx, "session")
+
+	getters := map[string]func(context.Context) (string, bool){
+		"trace":   GetTraceID,
+		"span":    GetSpanID,
+		"corr":    GetCorrelationID,
+		"req":     GetRequestID,
+		"user":    GetUserID,
+		"session": GetSessionID,
+	}
+	for expected, get := range getters {
+		if got, ok := get(ctx); !ok || got != expected {
+			t.Errorf("Expected %q, got %q (%v)", expected, got, ok)
+		}
+		if _, ok := get(context.Background()); ok {
+			t.Errorf("Expected no value for %q in empty context", expected)
+		}
+	}
+}
+
+func TestContextIDs_LegacyStringKeys(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "correlation_id", "legacy")
+	if got, ok := GetCorrelationID(ctx); !ok || got != "legacy" {
+		t.Errorf("Expected legacy string key to be read, got %q", got)
+	}
+
+	ctx = WithCorrelationID(ctx, "typed")
+	if got, _ := GetCorrelationID(ctx); got != "typed" {
+		t.Errorf("Expected typed key to take precedence, got %q", got)
+	}
+}
+
+func TestBaggage(t *testing.T) {
+	parent := WithBaggage(context.Background(), "tenant", "acme")
+	child := WithBaggage(parent, "workflow", "wf-1")
+
+	if _, ok := GetBaggage(parent, "workflow"); ok {
+		t.Error("Expected parent baggage to be unaffected by child")
+	}
+	if got, ok := GetBaggage(child, "tenant"); !ok || got != "acme" {
+		t.Errorf("Expected inherited baggage item, got %q", got)
+	}
+
+	baggage := Baggage(child)
+	baggage["tenant"] = "changed"
+	if got, _ := GetBaggage(child, "tenant"); got != "acme" {
+		t.Errorf("Expected Baggage to return a copy, got %q", got)
+	}
+
+	// Itens de baggage viram campos, sem sobrescrever campos extraídos
+	ctx := WithRequestID(WithBaggage(child, "request_id", "from-baggage"), "req-1")
+	fields := AppendContextFields(nil, ctx)
+	expected := []Field{
+		String("request_id", "req-1"),
+		String("tenant", "acme"),
+		String("workflow", "wf-1"),
+	}
+	if !reflect.DeepEqual(FieldsToMap(fields), FieldsToMap(expected)) || len(fields) != len(expected) {
+		t.Errorf("Expected %v, got %v", FieldsToMap(expected), FieldsToMap(fields))
+	}
+}
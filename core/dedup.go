@@ -0,0 +1,303 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RepeatCountKey é a chave do campo com a quantidade de repetições
+	// suprimidas, adicionado à entrada de resumo do DedupAdapter
+	RepeatCountKey = "repeat_count"
+	// FirstSeenKey é a chave do campo com o horário da primeira ocorrência
+	FirstSeenKey = "first_seen"
+	// LastSeenKey é a chave do campo com o horário da última repetição suprimida
+	LastSeenKey = "last_seen"
+)
+
+const (
+	// DefaultDedupWindow é a janela padrão de supressão de duplicatas
+	DefaultDedupWindow = 10 * time.Second
+	// DefaultDedupMaxKeys é o número padrão de entradas distintas rastreadas
+	DefaultDedupMaxKeys = 10000
+)
+
+// DedupConfig define a configuração da supressão de entradas duplicadas
+type DedupConfig struct {
+	// Enabled habilita a supressão de duplicatas
+	Enabled bool
+	// Window é a janela, a partir da primeira ocorrência, na qual entradas
+	// idênticas são suprimidas (padrão: 10s)
+	Window time.Duration
+	// Fields são os campos que, além do nível e da mensagem, compõem a
+	// chave de identificação das entradas duplicadas
+	Fields []string
+	// MaxKeys limita o número de entradas distintas rastreadas por janela.
+	// Entradas excedentes são registradas sem supressão (padrão: 10000).
+	MaxKeys int
+}
+
+// DefaultDedupConfig retorna a configuração padrão da supressão de
+// duplicatas, desabilitada até que WithEnabled(true) seja usado
+func DefaultDedupConfig() DedupConfig {
+	return DedupConfig{
+		Enabled: false,
+		Window:  DefaultDedupWindow,
+		MaxKeys: DefaultDedupMaxKeys,
+	}
+}
+
+// WithEnabled habilita ou desabilita a supressão de duplicatas
+func (c DedupConfig) WithEnabled(enabled bool) DedupConfig {
+	c.Enabled = enabled
+	return c
+}
+
+// WithWindow configura a janela de supressão
+func (c DedupConfig) WithWindow(window time.Duration) DedupConfig {
+	c.Window = window
+	return c
+}
+
+// WithFields configura os campos que compõem a chave de identificação
+func (c DedupConfig) WithFields(fields ...string) DedupConfig {
+	c.Fields = fields
+	return c
+}
+
+// WithMaxKeys configura o número máximo de entradas distintas rastreadas
+func (c DedupConfig) WithMaxKeys(maxKeys int) DedupConfig {
+	c.MaxKeys = maxKeys
+	return c
+}
+
+// Validate verifica se a configuração é válida
+func (c DedupConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("dedup window must be positive, got %s", c.Window)
+	}
+	if c.MaxKeys <= 0 {
+		return fmt.Errorf("dedup max keys must be positive, got %d", c.MaxKeys)
+	}
+	return nil
+}
+
+// dedupEntry é uma entrada rastreada durante a sua janela
+type dedupEntry struct {
+	ctx        context.Context
+	level      Level
+	msg        string
+	fields     map[string]interface{}
+	firstSeen  time.Time
+	lastSeen   time.Time
+	suppressed int
+	timer      *time.Timer
+}
+
+// dedupState é o estado compartilhado entre as cópias do DedupAdapter
+// criadas via WithContext
+type dedupState struct {
+	config DedupConfig
+	// base recebe as entradas de resumo
+	base LoggerAdapter
+
+	mu      sync.Mutex
+	entries map[string]*dedupEntry
+	closed  bool
+
+	unregisterShutdown func()
+}
+
+// DedupAdapter é um decorator que suprime entradas idênticas (mesmo nível,
+// mensagem e campos configurados) dentro de uma janela de tempo. A primeira
+// ocorrência é registrada imediatamente; ao fim da janela, se houve
+// repetições, uma entrada de resumo com os campos repeat_count, first_seen
+// e last_seen é registrada. Entradas FATAL e PANIC nunca são suprimidas.
+type DedupAdapter struct {
+	LoggerAdapter
+	state *dedupState
+}
+
+// NewDedupAdapter cria um DedupAdapter sobre o adapter especificado. Close
+// deve ser chamado para registrar os resumos pendentes.
+func NewDedupAdapter(adapter LoggerAdapter, config DedupConfig) *DedupAdapter {
+	if config.Window <= 0 {
+		config.Window = DefaultDedupWindow
+	}
+	if config.MaxKeys <= 0 {
+		config.MaxKeys = DefaultDedupMaxKeys
+	}
+
+	state := &dedupState{
+		config:  config,
+		base:    adapter,
+		entries: make(map[string]*dedupEntry),
+	}
+	// Garantir que os resumos pendentes sejam registrados antes do
+	// encerramento via Exit
+	state.unregisterShutdown = OnShutdown("dedup", func(ctx context.Context) error {
+		state.flush()
+		return nil
+	})
+
+	return &DedupAdapter{LoggerAdapter: adapter, state: state}
+}
+
+// Log implementa a interface LoggerAdapter suprimindo entradas duplicadas
+func (d *DedupAdapter) Log(ctx context.Context, level Level, msg string, fields map[string]interface{}) {
+	if level == FATAL || level == PANIC || !d.state.track(ctx, level, msg, fields) {
+		d.LoggerAdapter.Log(ctx, level, msg, fields)
+	}
+}
+
+// track registra a ocorrência da entrada. Retorna true se a entrada deve
+// ser suprimida.
+func (s *dedupState) track(ctx context.Context, level Level, msg string, fields map[string]interface{}) bool {
+	key := s.key(level, msg, fields)
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+	if entry, ok := s.entries[key]; ok {
+		entry.suppressed++
+		entry.lastSeen = now
+		return true
+	}
+	if len(s.entries) >= s.config.MaxKeys {
+		return false
+	}
+
+	entry := &dedupEntry{
+		ctx:       ctx,
+		level:     level,
+		msg:       msg,
+		fields:    copyFieldMap(fields),
+		firstSeen: now,
+		lastSeen:  now,
+	}
+	entry.timer = time.AfterFunc(s.config.Window, func() { s.expire(key, entry) })
+	s.entries[key] = entry
+	return false
+}
+
+// key monta a chave de identificação a partir do nível, da mensagem e dos
+// campos configurados
+func (s *dedupState) key(level Level, msg string, fields map[string]interface{}) string {
+	var b strings.Builder
+	b.WriteString(level.String())
+	b.WriteByte(0)
+	b.WriteString(msg)
+	for _, name := range s.config.Fields {
+		b.WriteByte(0)
+		if val, ok := fields[name]; ok {
+			fmt.Fprint(&b, val)
+		}
+	}
+	return b.String()
+}
+
+// expire encerra a janela da entrada e registra o resumo, se houve repetições
+func (s *dedupState) expire(key string, entry *dedupEntry) {
+	s.mu.Lock()
+	if s.entries[key] != entry {
+		s.mu.Unlock()
+		return
+	}
+	delete(s.entries, key)
+	s.mu.Unlock()
+
+	s.summarize(entry)
+}
+
+// flush encerra todas as janelas abertas e registra os resumos pendentes
+func (s *dedupState) flush() {
+	s.mu.Lock()
+	pending := make([]*dedupEntry, 0, len(s.entries))
+	for key, entry := range s.entries {
+		entry.timer.Stop()
+		pending = append(pending, entry)
+		delete(s.entries, key)
+	}
+	s.mu.Unlock()
+
+	for _, entry := range pending {
+		s.summarize(entry)
+	}
+}
+
+// summarize registra a entrada de resumo com a quantidade de repetições
+func (s *dedupState) summarize(entry *dedupEntry) {
+	if entry.suppressed == 0 {
+		return
+	}
+
+	fields := entry.fields
+	fields[RepeatCountKey] = entry.suppressed
+	fields[FirstSeenKey] = entry.firstSeen.UTC().Format(time.RFC3339Nano)
+	fields[LastSeenKey] = entry.lastSeen.UTC().Format(time.RFC3339Nano)
+	s.base.Log(entry.ctx, entry.level, entry.msg, fields)
+}
+
+// WithContext implementa a interface LoggerAdapter. O estado de supressão
+// é compartilhado com o adapter original.
+func (d *DedupAdapter) WithContext(ctx context.Context) LoggerAdapter {
+	return &DedupAdapter{
+		LoggerAdapter: d.LoggerAdapter.WithContext(ctx),
+		state:         d.state,
+	}
+}
+
+// WithHooks implementa a interface HookableAdapter, adicionando os hooks ao
+// adapter decorado. A deduplicação permanece compartilhada com o original.
+func (d *DedupAdapter) WithHooks(hooks ...Hook) LoggerAdapter {
+	return &DedupAdapter{
+		LoggerAdapter: WithAdapterHooks(d.LoggerAdapter, hooks...),
+		state:         d.state,
+	}
+}
+
+// IsComponentLevelEnabled implementa a interface ComponentLevelEnabler
+func (d *DedupAdapter) IsComponentLevelEnabled(component string, level Level) bool {
+	return IsComponentLevelEnabled(d.LoggerAdapter, component, level)
+}
+
+// Flush implementa a interface Flusher, encerrando as janelas abertas e
+// registrando os resumos pendentes antes de descarregar o adapter decorado
+func (d *DedupAdapter) Flush(ctx context.Context) error {
+	d.state.flush()
+	return FlushAdapter(ctx, d.LoggerAdapter)
+}
+
+// Close implementa a interface io.Closer. Os resumos pendentes são
+// registrados e as entradas seguintes deixam de ser suprimidas.
+func (d *DedupAdapter) Close() error {
+	d.state.mu.Lock()
+	alreadyClosed := d.state.closed
+	d.state.closed = true
+	d.state.mu.Unlock()
+
+	if !alreadyClosed {
+		d.state.flush()
+		d.state.unregisterShutdown()
+	}
+	return CloseAdapter(d.LoggerAdapter)
+}
+
+// copyFieldMap retorna uma cópia rasa dos campos
+func copyFieldMap(fields map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(fields)+3)
+	for k, v := range fields {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingAdapter é um LoggerAdapter seguro para uso concorrente, já que
+// os resumos do DedupAdapter são registrados a partir de timers
+type recordingAdapter struct {
+	mu    sync.Mutex
+	calls []logCall
+}
+
+func (r *recordingAdapter) Log(ctx context.Context, level Level, msg string, fields map[string]interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, logCall{ctx: ctx, level: level, msg: msg, fields: fields})
+}
+
+func (r *recordingAdapter) WithContext(ctx context.Context) LoggerAdapter { return r }
+
+func (r *recordingAdapter) IsLevelEnabled(level Level) bool { return true }
+
+func (r *recordingAdapter) snapshot() []logCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]logCall(nil), r.calls...)
+}
+
+func TestDedupAdapter_SuppressesDuplicates(t *testing.T) {
+	base := &recordingAdapter{}
+	adapter := NewDedupAdapter(base, DefaultDedupConfig().WithWindow(time.Hour).WithFields("host"))
+	defer adapter.Close()
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		adapter.Log(ctx, ERROR, "connection refused", map[string]interface{}{"host": "db-1", "attempt": i})
+	}
+	adapter.Log(ctx, ERROR, "connection refused", map[string]interface{}{"host": "db-2"})
+	adapter.Log(ctx, WARN, "connection refused", map[string]interface{}{"host": "db-1"})
+
+	calls := base.snapshot()
+	if len(calls) != 3 {
+		t.Fatalf("Expected first occurrences only (3 entries), got %d", len(calls))
+	}
+	if _, exists := calls[0].fields[RepeatCountKey]; exists {
+		t.Error("Expected first occurrence without repeat_count")
+	}
+
+	if err := adapter.Flush(ctx); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	calls = base.snapshot()
+	if len(calls) != 4 {
+		t.Fatalf("Expected a single summary entry after Flush, got %d entries", len(calls))
+	}
+	summary := calls[3]
+	if summary.level != ERROR || summary.msg != "connection refused" {
+		t.Errorf("Expected summary with the original level and message, got %v %q", summary.level, summary.msg)
+	}
+	if summary.fields[RepeatCountKey] != 4 {
+		t.Errorf("Expected repeat_count 4, got %v", summary.fields[RepeatCountKey])
+	}
+	if summary.fields["host"] != "db-1" || summary.fields["attempt"] != 0 {
+		t.Errorf("Expected summary to carry the first occurrence fields, got %v", summary.fields)
+	}
+	for _, key := range []string{FirstSeenKey, LastSeenKey} {
+		if _, err := time.Parse(time.RFC3339Nano, summary.fields[key].(string)); err != nil {
+			t.Errorf("Expected %s to be an RFC3339 timestamp, got %v", key, summary.fields[key])
+		}
+	}
+
+	// Após o fim da janela a próxima ocorrência é registrada novamente
+	adapter.Log(ctx, ERROR, "connection refused", map[string]interface{}{"host": "db-1"})
+	if calls := base.snapshot(); len(calls) != 5 {
+		t.Errorf("Expected new window to log the next occurrence, got %d entries", len(calls))
+	}
+}
+
+func TestDedupAdapter_WindowExpiry(t *testing.T) {
+	base := &recordingAdapter{}
+	adapter := NewDedupAdapter(base, DefaultDedupConfig().WithWindow(20*time.Millisecond))
+	defer adapter.Close()
+	ctx := context.Background()
+
+	adapter.Log(ctx, INFO, "once", nil)
+	adapter.Log(ctx, INFO, "repeated", nil)
+	adapter.Log(ctx, INFO, "repeated", nil)
+	adapter.Log(ctx, INFO, "repeated", nil)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(base.snapshot()) < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(30 * time.Millisecond)
+
+	calls := base.snapshot()
+	if len(calls) != 3 {
+		t.Fatalf("Expected 2 first occurrences and 1 summary, got %d", len(calls))
+	}
+	if calls[2].msg != "repeated" || calls[2].fields[RepeatCountKey] != 2 {
+		t.Errorf("Expected summary for 'repeated' with repeat_count 2, got %q %v", calls[2].msg, calls[2].fields)
+	}
+}
+
+func TestDedupAdapter_ExemptionsAndLimits(t *testing.T) {
+	base := &recordingAdapter{}
+	adapter := NewDedupAdapter(base, DefaultDedupConfig().WithWindow(time.Hour).WithMaxKeys(1))
+	ctx := context.Background()
+
+	adapter.Log(ctx, INFO, "tracked", nil)
+	adapter.Log(ctx, INFO, "untracked", nil)
+	adapter.Log(ctx, INFO, "untracked", nil)
+	adapter.Log(ctx, FATAL, "fatal", nil)
+	adapter.Log(ctx, FATAL, "fatal", nil)
+
+	if calls := base.snapshot(); len(calls) != 5 {
+		t.Errorf("Expected entries beyond MaxKeys and FATAL entries not to be suppressed, got %d", len(calls))
+	}
+
+	// Após o Close as entradas não são suprimidas
+	adapter.Close()
+	adapter.Log(ctx, INFO, "tracked", nil)
+	if calls := base.snapshot(); len(calls) != 6 {
+		t.Errorf("Expected entries after Close to be logged, got %d", len(calls))
+	}
+}
+
+func TestDedupConfig_Validate(t *testing.T) {
+	if err := DefaultDedupConfig().WithWindow(0).Validate(); err != nil {
+		t.Errorf("Expected disabled config to be valid, got %v", err)
+	}
+	if err := DefaultDedupConfig().WithEnabled(true).WithWindow(0).Validate(); err == nil {
+		t.Error("Expected error for zero window")
+	}
+	if err := DefaultDedupConfig().WithEnabled(true).WithMaxKeys(0).Validate(); err == nil {
+		t.Error("Expected error for zero max keys")
+	}
+}
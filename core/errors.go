@@ -0,0 +1,271 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ErrorKey é a chave do campo com a mensagem do erro
+	ErrorKey = "error"
+	// ErrorTypeKey é a chave do campo com o tipo concreto da causa raiz do erro
+	ErrorTypeKey = "error.type"
+	// ErrorChainKey é a chave do campo com a cadeia de erros encapsulados
+	ErrorChainKey = "error.chain"
+	// ErrorStackTraceKey é a chave do campo com o stack trace do erro
+	ErrorStackTraceKey = "error.stack_trace"
+)
+
+const (
+	// maxErrorChain limita o número de erros percorridos na cadeia,
+	// protegendo contra cadeias cíclicas ou excessivamente longas
+	maxErrorChain = 32
+	// maxStackDepth limita o número de frames capturados no call site
+	maxStackDepth = 32
+	// stackDepth é o número de frames entre runtime.Callers e o código do
+	// usuário: captureStack <- Err <- usuário
+	stackDepth = 3
+)
+
+// StackTracePolicy define quando o stack trace é capturado no call site de
+// Err para erros que não carregam um stack trace próprio
+type StackTracePolicy int
+
+const (
+	// StackTraceOnError captura o stack trace apenas em eventos ERROR ou superiores (padrão)
+	StackTraceOnError StackTracePolicy = iota
+	// StackTraceNever nunca captura o stack trace no call site
+	StackTraceNever
+	// StackTraceAlways captura o stack trace em todos os níveis
+	StackTraceAlways
+)
+
+// String retorna a representação em string da política
+func (p StackTracePolicy) String() string {
+	switch p {
+	case StackTraceOnError:
+		return "error"
+	case StackTraceNever:
+		return "never"
+	case StackTraceAlways:
+		return "always"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseStackTracePolicy converte uma string ("never", "error" ou "always")
+// em StackTracePolicy
+func ParseStackTracePolicy(policy string) (StackTracePolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(policy)) {
+	case "error", "on_error":
+		return StackTraceOnError, nil
+	case "never", "off", "none":
+		return StackTraceNever, nil
+	case "always":
+		return StackTraceAlways, nil
+	default:
+		return StackTraceOnError, fmt.Errorf("invalid stack trace policy: %s", policy)
+	}
+}
+
+// shouldCapture indica se o stack trace deve ser capturado para o nível
+func (p StackTracePolicy) shouldCapture(level Level) bool {
+	switch p {
+	case StackTraceAlways:
+		return true
+	case StackTraceOnError:
+		return level >= ERROR
+	default:
+		return false
+	}
+}
+
+// LogFieldsProvider é implementado por erros que carregam campos de log
+// estruturados. Ao chegar em LogEvent.Err, os campos de todos os erros da
+// cadeia são adicionados à entrada de log.
+type LogFieldsProvider interface {
+	// LogFields retorna os campos a serem adicionados à entrada de log
+	LogFields() map[string]interface{}
+}
+
+// WrapErr anexa campos de log a um erro sem alterar sua mensagem. O erro
+// original continua acessível via errors.Is/errors.As. Retorna nil se err
+// for nil.
+//
+// Exemplo:
+//
+//	if err := repo.Save(order); err != nil {
+//		return core.WrapErr(err, map[string]interface{}{"order_id": order.ID})
+//	}
+func WrapErr(err error, fields map[string]interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return &fieldsError{err: err, fields: fields}
+}
+
+// fieldsError é o erro retornado por WrapErr
+type fieldsError struct {
+	err    error
+	fields map[string]interface{}
+}
+
+// Error implementa a interface error
+func (e *fieldsError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap retorna o erro encapsulado
+func (e *fieldsError) Unwrap() error {
+	return e.err
+}
+
+// LogFields implementa a interface LogFieldsProvider
+func (e *fieldsError) LogFields() map[string]interface{} {
+	return e.fields
+}
+
+// errorChain é a cadeia de erros percorrida via Unwrap, incluindo os ramos
+// de errors.Join, em profundidade
+type errorChain []error
+
+// MarshalLogArray implementa a interface ArrayMarshaler
+func (c errorChain) MarshalLogArray(a LogArray) {
+	for _, err := range c {
+		a.Object(errorLink{err: err})
+	}
+}
+
+// links retorna a cadeia sem os erros criados por WrapErr, que apenas
+// anexam campos e repetiriam a mensagem do erro encapsulado
+func (c errorChain) links() errorChain {
+	links := make(errorChain, 0, len(c))
+	for _, err := range c {
+		if _, ok := err.(*fieldsError); !ok {
+			links = append(links, err)
+		}
+	}
+	return links
+}
+
+// errorLink é a representação em log de um elo da cadeia de erros
+type errorLink struct {
+	err error
+}
+
+// MarshalLogObject implementa a interface ObjectMarshaler
+func (l errorLink) MarshalLogObject(e LogEvent) {
+	e.Str("type", fmt.Sprintf("%T", l.err)).
+		Str("message", l.err.Error())
+}
+
+// unwrapChain percorre a cadeia de erros a partir de err
+func unwrapChain(err error) errorChain {
+	chain := make(errorChain, 0, 4)
+
+	var walk func(err error)
+	walk = func(err error) {
+		if err == nil || len(chain) >= maxErrorChain {
+			return
+		}
+		chain = append(chain, err)
+
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			walk(x.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, child := range x.Unwrap() {
+				walk(child)
+			}
+		}
+	}
+	walk(err)
+
+	return chain
+}
+
+// rootCause retorna o erro mais interno seguindo Unwrap simples. A busca
+// para em erros com múltiplos ramos (errors.Join), que não têm causa única.
+func rootCause(err error) error {
+	for i := 0; i < maxErrorChain; i++ {
+		wrapper, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			return err
+		}
+		next := wrapper.Unwrap()
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+	return err
+}
+
+// carriedStackTrace retorna o stack trace carregado pelo erro mais interno
+// da cadeia que possua um. São reconhecidos erros com um método
+// StackTrace() retornando string, []uintptr ou um tipo formatável com %+v
+// (como github.com/pkg/errors).
+func carriedStackTrace(chain errorChain) string {
+	for i := len(chain) - 1; i >= 0; i-- {
+		if trace := stackTraceOf(chain[i]); trace != "" {
+			return trace
+		}
+	}
+	return ""
+}
+
+// stackTraceOf extrai o stack trace de um único erro, se disponível
+func stackTraceOf(err error) string {
+	method := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return ""
+	}
+
+	switch trace := method.Call(nil)[0].Interface().(type) {
+	case string:
+		return trace
+	case []uintptr:
+		return formatFrames(trace)
+	case nil:
+		return ""
+	default:
+		return strings.TrimPrefix(fmt.Sprintf("%+v", trace), "\n")
+	}
+}
+
+// captureStack captura o stack trace a partir do código que chamou Err
+func captureStack() string {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(stackDepth, pcs)
+	return formatFrames(pcs[:n])
+}
+
+// formatFrames formata os program counters no mesmo formato de
+// github.com/pkg/errors: função seguida de arquivo:linha indentado
+func formatFrames(pcs []uintptr) string {
+	if len(pcs) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		if sb.Len() > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(frame.Function)
+		sb.WriteString("\n\t")
+		sb.WriteString(frame.File)
+		sb.WriteByte(':')
+		sb.WriteString(strconv.Itoa(frame.Line))
+		if !more {
+			break
+		}
+	}
+	return sb.String()
+}
@@ -3,6 +3,9 @@ package core
 import (
 	"context"
 	"fmt"
+	"net"
+	"sync"
+	"time"
 )
 
 // LogEvent define a interface para construção fluente de entradas de log
@@ -21,15 +24,76 @@ type LogEvent interface {
 	// Bool adiciona um campo booleano à entrada de log
 	Bool(key string, val bool) LogEvent
 
-	// Err adiciona um erro à entrada de log com a chave "error"
+	// Int64 adiciona um campo int64 à entrada de log
+	Int64(key string, val int64) LogEvent
+
+	// Uint64 adiciona um campo uint64 à entrada de log
+	Uint64(key string, val uint64) LogEvent
+
+	// Dur adiciona um campo de duração à entrada de log
+	Dur(key string, val time.Duration) LogEvent
+
+	// Time adiciona um campo de data/hora à entrada de log
+	Time(key string, val time.Time) LogEvent
+
+	// Strs adiciona um campo com uma lista de strings à entrada de log
+	Strs(key string, vals []string) LogEvent
+
+	// Ints adiciona um campo com uma lista de inteiros à entrada de log
+	Ints(key string, vals []int) LogEvent
+
+	// Bytes adiciona um campo []byte renderizado como string à entrada de log
+	Bytes(key string, val []byte) LogEvent
+
+	// Hex adiciona um campo []byte renderizado em hexadecimal à entrada de log
+	Hex(key string, val []byte) LogEvent
+
+	// IPAddr adiciona um campo com um endereço IP à entrada de log
+	IPAddr(key string, ip net.IP) LogEvent
+
+	// Stringer adiciona um campo com o resultado de val.String() à entrada de log
+	Stringer(key string, val fmt.Stringer) LogEvent
+
+	// Dict adiciona um objeto aninhado à entrada de log. Os campos do objeto
+	// são definidos pela função fn através do LogEvent recebido, no qual
+	// Msg, Msgf e Send não têm efeito.
+	Dict(key string, fn func(LogEvent)) LogEvent
+
+	// Object adiciona um objeto aninhado cuja representação é definida pelo
+	// próprio tipo através de ObjectMarshaler
+	Object(key string, obj ObjectMarshaler) LogEvent
+
+	// Array adiciona um array cuja representação é definida pelo próprio
+	// tipo através de ArrayMarshaler
+	Array(key string, arr ArrayMarshaler) LogEvent
+
+	// Err adiciona um erro à entrada de log com a chave "error", além do tipo
+	// da causa raiz ("error.type"), da cadeia de erros encapsulados
+	// ("error.chain") e do stack trace ("error.stack_trace") quando disponível.
+	// Campos anexados via WrapErr ou LogFieldsProvider em qualquer camada da
+	// cadeia também são adicionados.
 	Err(err error) LogEvent
 
 	// Any adiciona um campo de qualquer tipo à entrada de log
 	Any(key string, val interface{}) LogEvent
 
+	// Func adiciona um campo cujo valor é calculado por fn apenas quando a
+	// entrada é escrita, após a verificação de nível, a amostragem e os hooks
+	Func(key string, fn func() interface{}) LogEvent
+
 	// Fields adiciona múltiplos campos de uma vez à entrada de log
 	Fields(fields map[string]interface{}) LogEvent
 
+	// TypedFields adiciona campos tipados já construídos à entrada de log
+	TypedFields(fields ...Field) LogEvent
+
+	// RateLimited limita a taxa de entradas a perSecond por segundo, por
+	// call site de Msg, Msgf ou Send ou pela chave especificada quando não
+	// vazia, sobrescrevendo o limite padrão do logger. perSecond igual a
+	// zero remove o limite. A próxima entrada registrada após supressões
+	// recebe o campo "suppressed" com a quantidade suprimida.
+	RateLimited(key string, perSecond float64) LogEvent
+
 	// Msg finaliza a construção da entrada de log e a envia com a mensagem especificada
 	Msg(msg string)
 
@@ -38,90 +102,407 @@ type LogEvent interface {
 
 	// Send finaliza a construção da entrada de log e a envia sem mensagem adicional
 	Send()
+
+	// Enabled informa se a entrada de log será registrada. Permite evitar
+	// a construção de campos custosos quando o nível está desabilitado.
+	Enabled() bool
+}
+
+// maxPooledFields limita a capacidade dos slices devolvidos ao pool para
+// evitar que eventos excepcionalmente grandes fiquem retidos em memória
+const maxPooledFields = 256
+
+// eventPool reutiliza instâncias de logEvent entre chamadas de log
+var eventPool = sync.Pool{
+	New: func() interface{} {
+		return &logEvent{fields: make([]Field, 0, 16)}
+	},
 }
 
-// logEvent é a implementação concreta da interface LogEvent
+// logEvent é a implementação concreta da interface LogEvent.
+// As instâncias são obtidas de um pool e devolvidas após Msg, Msgf ou Send,
+// portanto um evento não deve ser reutilizado depois de enviado.
 type logEvent struct {
 	adapter LoggerAdapter
 	ctx     context.Context
 	level   Level
-	fields  map[string]interface{}
+	fields  []Field
+	// opts contém as opções compartilhadas do logger que criou o evento
+	opts *EventOptions
+	// nested indica um evento usado apenas para coletar os campos de um Dict
+	nested bool
+	// rateKey, rateLimit e rateLimitSet contêm o limite de taxa definido via
+	// RateLimited, que tem precedência sobre EventOptions.RateLimit
+	rateKey      string
+	rateLimit    float64
+	rateLimitSet bool
 }
 
 // NewLogEvent cria uma nova instância de LogEvent
 func NewLogEvent(adapter LoggerAdapter, ctx context.Context, level Level) LogEvent {
-	return &logEvent{
-		adapter: adapter,
-		ctx:     ctx,
-		level:   level,
-		fields:  make(map[string]interface{}),
-	}
+	e := eventPool.Get().(*logEvent)
+	e.adapter = adapter
+	e.ctx = ctx
+	e.level = level
+	return e
 }
 
 // Str adiciona um campo string à entrada de log
 func (e *logEvent) Str(key, val string) LogEvent {
-	e.fields[key] = val
+	e.appendField(String(key, val))
 	return e
 }
 
 // Int adiciona um campo inteiro à entrada de log
 func (e *logEvent) Int(key string, val int) LogEvent {
-	e.fields[key] = val
+	e.appendField(Int(key, val))
 	return e
 }
 
 // Float64 adiciona um campo float64 à entrada de log
 func (e *logEvent) Float64(key string, val float64) LogEvent {
-	e.fields[key] = val
+	e.appendField(Float64(key, val))
 	return e
 }
 
 // Bool adiciona um campo booleano à entrada de log
 func (e *logEvent) Bool(key string, val bool) LogEvent {
-	e.fields[key] = val
+	e.appendField(Bool(key, val))
+	return e
+}
+
+// Int64 adiciona um campo int64 à entrada de log
+func (e *logEvent) Int64(key string, val int64) LogEvent {
+	e.appendField(Int64(key, val))
+	return e
+}
+
+// Uint64 adiciona um campo uint64 à entrada de log
+func (e *logEvent) Uint64(key string, val uint64) LogEvent {
+	e.appendField(Uint64(key, val))
+	return e
+}
+
+// Dur adiciona um campo de duração à entrada de log
+func (e *logEvent) Dur(key string, val time.Duration) LogEvent {
+	e.appendField(Duration(key, val))
+	return e
+}
+
+// Time adiciona um campo de data/hora à entrada de log
+func (e *logEvent) Time(key string, val time.Time) LogEvent {
+	e.appendField(Time(key, val))
+	return e
+}
+
+// Strs adiciona um campo com uma lista de strings à entrada de log
+func (e *logEvent) Strs(key string, vals []string) LogEvent {
+	e.appendField(Strings(key, vals))
+	return e
+}
+
+// Ints adiciona um campo com uma lista de inteiros à entrada de log
+func (e *logEvent) Ints(key string, vals []int) LogEvent {
+	e.appendField(Ints(key, vals))
+	return e
+}
+
+// Bytes adiciona um campo []byte renderizado como string à entrada de log
+func (e *logEvent) Bytes(key string, val []byte) LogEvent {
+	e.appendField(Bytes(key, val))
+	return e
+}
+
+// Hex adiciona um campo []byte renderizado em hexadecimal à entrada de log
+func (e *logEvent) Hex(key string, val []byte) LogEvent {
+	e.appendField(Hex(key, val))
+	return e
+}
+
+// IPAddr adiciona um campo com um endereço IP à entrada de log
+func (e *logEvent) IPAddr(key string, ip net.IP) LogEvent {
+	e.appendField(IPAddr(key, ip))
+	return e
+}
+
+// Stringer adiciona um campo com o resultado de val.String() à entrada de log
+func (e *logEvent) Stringer(key string, val fmt.Stringer) LogEvent {
+	e.appendField(Stringer(key, val))
+	return e
+}
+
+// Dict adiciona um objeto aninhado à entrada de log
+func (e *logEvent) Dict(key string, fn func(LogEvent)) LogEvent {
+	e.appendField(Dict(key, collectFields(fn)...))
+	return e
+}
+
+// Object adiciona um objeto que implementa ObjectMarshaler à entrada de log
+func (e *logEvent) Object(key string, obj ObjectMarshaler) LogEvent {
+	e.appendField(Object(key, obj))
+	return e
+}
+
+// Array adiciona um array que implementa ArrayMarshaler à entrada de log
+func (e *logEvent) Array(key string, arr ArrayMarshaler) LogEvent {
+	e.appendField(Array(key, arr))
 	return e
 }
 
 // Err adiciona um erro à entrada de log
 func (e *logEvent) Err(err error) LogEvent {
-	if err != nil {
-		e.fields["error"] = err.Error()
+	if err == nil {
+		return e
+	}
+
+	chain := unwrapChain(err)
+
+	// Campos anexados ao longo da cadeia, das camadas internas para as
+	// externas, de forma que as externas prevaleçam em chaves repetidas
+	for i := len(chain) - 1; i >= 0; i-- {
+		if provider, ok := chain[i].(LogFieldsProvider); ok {
+			for key, val := range provider.LogFields() {
+				e.appendField(Any(key, val))
+			}
+		}
+	}
+
+	e.appendField(String(ErrorKey, err.Error()))
+	e.appendField(String(ErrorTypeKey, fmt.Sprintf("%T", rootCause(err))))
+
+	if links := chain.links(); len(links) > 1 {
+		e.appendField(Array(ErrorChainKey, links))
+	}
+
+	if trace := carriedStackTrace(chain); trace != "" {
+		e.appendField(String(ErrorStackTraceKey, trace))
+	} else if e.Enabled() && e.stackTracePolicy().shouldCapture(e.level) {
+		e.appendField(String(ErrorStackTraceKey, captureStack()))
 	}
 	return e
 }
 
 // Any adiciona um campo de qualquer tipo à entrada de log
 func (e *logEvent) Any(key string, val interface{}) LogEvent {
-	e.fields[key] = val
+	e.appendField(Any(key, val))
 	return e
 }
 
 // Fields adiciona múltiplos campos de uma vez à entrada de log
 func (e *logEvent) Fields(fields map[string]interface{}) LogEvent {
 	for k, v := range fields {
-		e.fields[k] = v
+		e.appendField(Any(k, v))
+	}
+	return e
+}
+
+// TypedFields adiciona campos tipados já construídos à entrada de log
+func (e *logEvent) TypedFields(fields ...Field) LogEvent {
+	for i := range fields {
+		e.appendField(fields[i])
 	}
 	return e
 }
 
 // Msg finaliza a construção da entrada de log e a envia
 func (e *logEvent) Msg(msg string) {
-	if e.adapter.IsLevelEnabled(e.level) {
-		e.adapter.Log(e.ctx, e.level, msg, e.fields)
+	if e.nested {
+		return
 	}
+	e.finish(msg)
 }
 
 // Msgf finaliza a construção da entrada de log e a envia com formatação
 func (e *logEvent) Msgf(format string, args ...interface{}) {
-	if e.adapter.IsLevelEnabled(e.level) {
-		msg := fmt.Sprintf(format, args...)
-		e.adapter.Log(e.ctx, e.level, msg, e.fields)
+	if e.nested {
+		return
 	}
+	// Níveis desabilitados evitam a formatação, exceto PANIC e FATAL, que
+	// disparam o panic ou encerram o processo mesmo desabilitados
+	if !e.Enabled() && e.level != PANIC && e.level != FATAL {
+		e.release()
+		return
+	}
+	e.finish(fmt.Sprintf(format, args...))
 }
 
 // Send finaliza a construção da entrada de log e a envia sem mensagem
 func (e *logEvent) Send() {
-	if e.adapter.IsLevelEnabled(e.level) {
-		e.adapter.Log(e.ctx, e.level, "", e.fields)
+	if e.nested {
+		return
+	}
+	e.finish("")
+}
+
+// finish envia a entrada se o nível estiver habilitado e o limite de taxa
+// permitir, e devolve o evento ao pool. Entradas de nível PANIC disparam um
+// panic com a mensagem após o envio, mesmo quando o nível está desabilitado.
+func (e *logEvent) finish(msg string) {
+	level := e.level
+	if e.Enabled() && e.allowRate() {
+		if e.opts != nil && e.opts.CallerEnabled {
+			e.captureCaller(e.opts.CallerSkip)
+		}
+		e.write(msg)
+	}
+	e.release()
+
+	switch level {
+	case PANIC:
+		panic(msg)
+	case FATAL:
+		Exit(1)
 	}
 }
+
+// Enabled informa se o nível do evento está habilitado no adapter para o
+// componente da entrada
+func (e *logEvent) Enabled() bool {
+	return e.adapter != nil && IsComponentLevelEnabled(e.adapter, ComponentFromTypedFields(e.fields), e.level)
+}
+
+// appendField adiciona um campo ao evento, substituindo o valor anterior
+// caso a chave já exista (mesma semântica de um map)
+func (e *logEvent) appendField(f Field) {
+	for i := range e.fields {
+		if e.fields[i].Key == f.Key {
+			e.fields[i] = f
+			return
+		}
+	}
+	e.fields = append(e.fields, f)
+}
+
+// write encaminha o evento para o adapter, usando o caminho tipado quando suportado
+func (e *logEvent) write(msg string) {
+	if typed, ok := e.adapter.(TypedLoggerAdapter); ok {
+		typed.LogTyped(e.ctx, e.level, msg, e.fields)
+		return
+	}
+	e.adapter.Log(e.ctx, e.level, msg, FieldsToMap(e.fields))
+}
+
+// release limpa o evento e o devolve ao pool
+func (e *logEvent) release() {
+	if cap(e.fields) > maxPooledFields {
+		return
+	}
+	clear(e.fields)
+	e.fields = e.fields[:0]
+	e.adapter = nil
+	e.ctx = nil
+	e.opts = nil
+	e.nested = false
+	e.rateKey = ""
+	e.rateLimit = 0
+	e.rateLimitSet = false
+	eventPool.Put(e)
+}
+
+// collectFields executa fn sobre um evento aninhado e retorna uma cópia
+// dos campos adicionados
+func collectFields(fn func(LogEvent)) []Field {
+	child := eventPool.Get().(*logEvent)
+	child.nested = true
+	fn(child)
+
+	fields := make([]Field, len(child.fields))
+	copy(fields, child.fields)
+	child.release()
+
+	return fields
+}
+
+// disabledEvent é um LogEvent que ignora todas as chamadas encadeadas.
+// É usado quando o nível de log está desabilitado, evitando a alocação
+// do evento e a cópia de campos pré-definidos.
+type disabledEvent struct{}
+
+// disabled é a instância compartilhada de disabledEvent
+var disabled LogEvent = disabledEvent{}
+
+// DisabledEvent retorna o LogEvent compartilhado que descarta todas as chamadas
+func DisabledEvent() LogEvent {
+	return disabled
+}
+
+// Str ignora o campo
+func (d disabledEvent) Str(key, val string) LogEvent { return disabled }
+
+// Int ignora o campo
+func (d disabledEvent) Int(key string, val int) LogEvent { return disabled }
+
+// Float64 ignora o campo
+func (d disabledEvent) Float64(key string, val float64) LogEvent { return disabled }
+
+// Bool ignora o campo
+func (d disabledEvent) Bool(key string, val bool) LogEvent { return disabled }
+
+// Int64 ignora o campo
+func (d disabledEvent) Int64(key string, val int64) LogEvent { return disabled }
+
+// Uint64 ignora o campo
+func (d disabledEvent) Uint64(key string, val uint64) LogEvent { return disabled }
+
+// Dur ignora o campo
+func (d disabledEvent) Dur(key string, val time.Duration) LogEvent { return disabled }
+
+// Time ignora o campo
+func (d disabledEvent) Time(key string, val time.Time) LogEvent { return disabled }
+
+// Strs ignora o campo
+func (d disabledEvent) Strs(key string, vals []string) LogEvent { return disabled }
+
+// Ints ignora o campo
+func (d disabledEvent) Ints(key string, vals []int) LogEvent { return disabled }
+
+// Bytes ignora o campo
+func (d disabledEvent) Bytes(key string, val []byte) LogEvent { return disabled }
+
+// Hex ignora o campo
+func (d disabledEvent) Hex(key string, val []byte) LogEvent { return disabled }
+
+// IPAddr ignora o campo
+func (d disabledEvent) IPAddr(key string, ip net.IP) LogEvent { return disabled }
+
+// Stringer ignora o campo sem chamar val.String()
+func (d disabledEvent) Stringer(key string, val fmt.Stringer) LogEvent { return disabled }
+
+// Dict ignora o objeto sem executar fn
+func (d disabledEvent) Dict(key string, fn func(LogEvent)) LogEvent { return disabled }
+
+// Object ignora o objeto sem executar MarshalLogObject
+func (d disabledEvent) Object(key string, obj ObjectMarshaler) LogEvent { return disabled }
+
+// Array ignora o array sem executar MarshalLogArray
+func (d disabledEvent) Array(key string, arr ArrayMarshaler) LogEvent { return disabled }
+
+// Err ignora o erro
+func (d disabledEvent) Err(err error) LogEvent { return disabled }
+
+// Any ignora o campo
+func (d disabledEvent) Any(key string, val interface{}) LogEvent { return disabled }
+
+// Func ignora o campo sem executar fn
+func (d disabledEvent) Func(key string, fn func() interface{}) LogEvent { return disabled }
+
+// Fields ignora os campos
+func (d disabledEvent) Fields(fields map[string]interface{}) LogEvent { return disabled }
+
+// TypedFields ignora os campos
+func (d disabledEvent) TypedFields(fields ...Field) LogEvent { return disabled }
+
+// RateLimited ignora o limite de taxa
+func (d disabledEvent) RateLimited(key string, perSecond float64) LogEvent { return disabled }
+
+// Msg descarta a entrada de log
+func (d disabledEvent) Msg(msg string) {}
+
+// Msgf descarta a entrada de log sem formatar a mensagem
+func (d disabledEvent) Msgf(format string, args ...interface{}) {}
+
+// Send descarta a entrada de log
+func (d disabledEvent) Send() {}
+
+// Enabled sempre retorna false
+func (d disabledEvent) Enabled() bool { return false }
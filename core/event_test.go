@@ -3,7 +3,13 @@ package core
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewLogEvent(t *testing.T) {
@@ -142,6 +148,196 @@ func TestLogEvent_Err_Nil(t *testing.T) {
 	}
 }
 
+// tracedError simula erros que carregam o próprio stack trace
+type tracedError struct {
+	msg string
+	pcs []uintptr
+}
+
+func (e *tracedError) Error() string         { return e.msg }
+func (e *tracedError) StackTrace() []uintptr { return e.pcs }
+
+func newTracedError(msg string) error {
+	pcs := make([]uintptr, 8)
+	n := runtime.Callers(1, pcs)
+	return &tracedError{msg: msg, pcs: pcs[:n]}
+}
+
+func TestLogEvent_Err_Chain(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+
+	root := &net.AddrError{Err: "bad address", Addr: "x"}
+	wrapped := fmt.Errorf("connect: %w", root)
+	joined := errors.Join(wrapped, errors.New("cleanup failed"))
+
+	NewLogEvent(adapter, ctx, WARN).Err(wrapped).Msg("wrapped")
+	NewLogEvent(adapter, ctx, WARN).Err(joined).Msg("joined")
+	NewLogEvent(adapter, ctx, WARN).Err(errors.New("plain")).Msg("plain")
+
+	fields := adapter.logCalls[0].fields
+	if fields[ErrorKey] != wrapped.Error() {
+		t.Errorf("Expected error message %q, got %v", wrapped.Error(), fields[ErrorKey])
+	}
+	if fields[ErrorTypeKey] != "*net.AddrError" {
+		t.Errorf("Expected root cause type *net.AddrError, got %v", fields[ErrorTypeKey])
+	}
+	chain, ok := fields[ErrorChainKey].([]interface{})
+	if !ok || len(chain) != 2 {
+		t.Fatalf("Expected chain with 2 links, got %#v", fields[ErrorChainKey])
+	}
+	expectedLinks := []map[string]interface{}{
+		{"type": "*fmt.wrapError", "message": wrapped.Error()},
+		{"type": "*net.AddrError", "message": root.Error()},
+	}
+	for i, expected := range expectedLinks {
+		if !reflect.DeepEqual(chain[i], expected) {
+			t.Errorf("Chain link %d: expected %v, got %v", i, expected, chain[i])
+		}
+	}
+
+	fields = adapter.logCalls[1].fields
+	if fields[ErrorTypeKey] != "*errors.joinError" {
+		t.Errorf("Expected joined error type, got %v", fields[ErrorTypeKey])
+	}
+	if chain, _ := fields[ErrorChainKey].([]interface{}); len(chain) != 4 {
+		t.Errorf("Expected joined chain with 4 links, got %v", fields[ErrorChainKey])
+	}
+
+	fields = adapter.logCalls[2].fields
+	if fields[ErrorTypeKey] != "*errors.errorString" {
+		t.Errorf("Expected *errors.errorString, got %v", fields[ErrorTypeKey])
+	}
+	if _, exists := fields[ErrorChainKey]; exists {
+		t.Error("Expected no chain for unwrapped errors")
+	}
+}
+
+func TestLogEvent_Err_StackTracePolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   StackTracePolicy
+		level    Level
+		expected bool
+	}{
+		{"on error at ERROR", StackTraceOnError, ERROR, true},
+		{"on error at WARN", StackTraceOnError, WARN, false},
+		{"never at ERROR", StackTraceNever, ERROR, false},
+		{"always at DEBUG", StackTraceAlways, DEBUG, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adapter := newMockAdapter()
+			opts := DefaultEventOptions().WithStackTrace(tt.policy)
+			NewLogEventWithOptions(adapter, context.Background(), tt.level, &opts).
+				Err(errors.New("boom")).
+				Msg("failed")
+
+			trace, exists := adapter.logCalls[0].fields[ErrorStackTraceKey].(string)
+			if exists != tt.expected {
+				t.Fatalf("Expected stack trace present=%v, got %v", tt.expected, exists)
+			}
+			if exists && !strings.HasPrefix(trace, "github.com/victorximenis/logger/core.TestLogEvent_Err_StackTracePolicy") {
+				t.Errorf("Expected stack trace to start at the caller of Err, got:\n%s", trace)
+			}
+		})
+	}
+
+	// Eventos sem opções usam a política padrão
+	adapter := newMockAdapter()
+	NewLogEvent(adapter, context.Background(), ERROR).Err(errors.New("boom")).Msg("failed")
+	if _, exists := adapter.logCalls[0].fields[ErrorStackTraceKey]; !exists {
+		t.Error("Expected stack trace with default policy at ERROR")
+	}
+}
+
+func TestLogEvent_Err_CarriedStackTrace(t *testing.T) {
+	adapter := newMockAdapter()
+	opts := DefaultEventOptions().WithStackTrace(StackTraceNever)
+
+	err := fmt.Errorf("handler: %w", newTracedError("origin"))
+	NewLogEventWithOptions(adapter, context.Background(), ERROR, &opts).Err(err).Msg("failed")
+
+	fields := adapter.logCalls[0].fields
+	trace, _ := fields[ErrorStackTraceKey].(string)
+	if !strings.HasPrefix(trace, "github.com/victorximenis/logger/core.newTracedError") {
+		t.Errorf("Expected stack trace carried by the error, got:\n%s", trace)
+	}
+	if fields[ErrorTypeKey] != "*core.tracedError" {
+		t.Errorf("Expected *core.tracedError, got %v", fields[ErrorTypeKey])
+	}
+}
+
+func TestLogEvent_Err_Fields(t *testing.T) {
+	adapter := newMockAdapter()
+
+	inner := WrapErr(errors.New("duplicate key"), map[string]interface{}{
+		"table":    "orders",
+		"order_id": "inner",
+	})
+	middle := fmt.Errorf("save order: %w", inner)
+	outer := WrapErr(middle, map[string]interface{}{
+		"order_id": "ord-1",
+		"tenant":   "acme",
+	})
+
+	NewLogEvent(adapter, context.Background(), WARN).Err(outer).Msg("request failed")
+
+	fields := adapter.logCalls[0].fields
+	expected := map[string]interface{}{
+		"table":    "orders",
+		"order_id": "ord-1", // camadas externas prevalecem
+		"tenant":   "acme",
+		ErrorKey:   "save order: duplicate key",
+	}
+	for key, val := range expected {
+		if fields[key] != val {
+			t.Errorf("Expected %s=%v, got %v", key, val, fields[key])
+		}
+	}
+
+	if fields[ErrorTypeKey] != "*errors.errorString" {
+		t.Errorf("Expected root cause type *errors.errorString, got %v", fields[ErrorTypeKey])
+	}
+	chain, _ := fields[ErrorChainKey].([]interface{})
+	if len(chain) != 2 {
+		t.Errorf("Expected WrapErr layers to be omitted from the chain, got %v", fields[ErrorChainKey])
+	}
+
+	if !errors.Is(outer, errors.Unwrap(inner)) {
+		t.Error("Expected WrapErr to preserve errors.Is")
+	}
+	if WrapErr(nil, map[string]interface{}{"k": "v"}) != nil {
+		t.Error("Expected WrapErr(nil) to return nil")
+	}
+}
+
+func TestParseStackTracePolicy(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected StackTracePolicy
+		wantErr  bool
+	}{
+		{"error", StackTraceOnError, false},
+		{"NEVER", StackTraceNever, false},
+		{" always ", StackTraceAlways, false},
+		{"sometimes", StackTraceOnError, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			policy, err := ParseStackTracePolicy(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseStackTracePolicy(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if policy != tt.expected {
+				t.Errorf("ParseStackTracePolicy(%q) = %v, expected %v", tt.input, policy, tt.expected)
+			}
+		})
+	}
+}
+
 func TestLogEvent_Any(t *testing.T) {
 	adapter := newMockAdapter()
 	ctx := context.Background()
@@ -327,3 +523,321 @@ func TestLogEvent_LevelEnabled(t *testing.T) {
 		t.Errorf("Expected 1 log call when level is enabled, got %d", len(adapter.logCalls))
 	}
 }
+
+func TestLogEvent_TypedFields(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+	event := NewLogEvent(adapter, ctx, INFO)
+
+	result := event.TypedFields(String("service", "auth"), Int("attempt", 3))
+
+	if result != event {
+		t.Error("TypedFields should return the same event for method chaining")
+	}
+
+	event.Msg("typed fields")
+
+	fields := adapter.logCalls[0].fields
+	if fields["service"] != "auth" {
+		t.Errorf("Expected field service='auth', got %v", fields["service"])
+	}
+	if fields["attempt"] != 3 {
+		t.Errorf("Expected field attempt=3, got %v", fields["attempt"])
+	}
+}
+
+func TestLogEvent_DuplicateKeyKeepsLastValue(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+
+	NewLogEvent(adapter, ctx, INFO).
+		Str("key", "first").
+		Str("key", "second").
+		Msg("duplicate key")
+
+	fields := adapter.logCalls[0].fields
+	if len(fields) != 1 {
+		t.Errorf("Expected 1 field, got %d", len(fields))
+	}
+	if fields["key"] != "second" {
+		t.Errorf("Expected field key='second', got %v", fields["key"])
+	}
+}
+
+// typedMockAdapter é um mock que implementa TypedLoggerAdapter
+type typedMockAdapter struct {
+	*mockAdapter
+	typedCalls [][]Field
+}
+
+func (m *typedMockAdapter) LogTyped(ctx context.Context, level Level, msg string, fields []Field) {
+	copied := make([]Field, len(fields))
+	copy(copied, fields)
+	m.typedCalls = append(m.typedCalls, copied)
+}
+
+func TestLogEvent_UsesTypedAdapter(t *testing.T) {
+	adapter := &typedMockAdapter{mockAdapter: newMockAdapter()}
+	ctx := context.Background()
+
+	NewLogEvent(adapter, ctx, INFO).
+		Str("key", "value").
+		Int("count", 1).
+		Msg("typed message")
+
+	if len(adapter.logCalls) != 0 {
+		t.Errorf("Expected map-based Log not to be called, got %d calls", len(adapter.logCalls))
+	}
+	if len(adapter.typedCalls) != 1 {
+		t.Fatalf("Expected 1 typed log call, got %d", len(adapter.typedCalls))
+	}
+
+	fields := adapter.typedCalls[0]
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if fields[0].Type != StringType || fields[0].String != "value" {
+		t.Errorf("Expected typed string field, got %+v", fields[0])
+	}
+	if fields[1].Type != IntType || fields[1].Integer != 1 {
+		t.Errorf("Expected typed int field, got %+v", fields[1])
+	}
+}
+
+func TestLogEvent_Enabled(t *testing.T) {
+	adapter := newMockAdapter()
+	adapter.setLevelEnabled(DEBUG, false)
+	ctx := context.Background()
+
+	if NewLogEvent(adapter, ctx, DEBUG).Enabled() {
+		t.Error("Enabled should return false when level is disabled")
+	}
+	if !NewLogEvent(adapter, ctx, INFO).Enabled() {
+		t.Error("Enabled should return true when level is enabled")
+	}
+}
+
+func TestDisabledEvent(t *testing.T) {
+	event := DisabledEvent()
+
+	if event.Enabled() {
+		t.Error("DisabledEvent should not be enabled")
+	}
+
+	// Todas as chamadas encadeadas devem retornar o mesmo evento desabilitado
+	result := event.
+		Str("key", "value").
+		Int("count", 1).
+		Float64("ratio", 0.5).
+		Bool("ok", true).
+		Err(errors.New("test")).
+		Any("any", struct{}{}).
+		Fields(map[string]interface{}{"k": "v"}).
+		TypedFields(String("typed", "value"))
+
+	if result != event {
+		t.Error("DisabledEvent should return itself for method chaining")
+	}
+
+	// Não deve causar panic
+	result.Msg("ignored")
+	result.Msgf("ignored %d", 1)
+	result.Send()
+}
+
+// testStringer é um fmt.Stringer usado nos testes
+type testStringer struct{ value string }
+
+func (s testStringer) String() string { return s.value }
+
+func TestLogEvent_RichSetters(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+	timestamp := time.Date(2024, 5, 10, 12, 30, 0, 0, time.UTC)
+
+	NewLogEvent(adapter, ctx, INFO).
+		Int64("int64", 1<<40).
+		Uint64("uint64", 1<<63).
+		Dur("elapsed", 1500*time.Millisecond).
+		Time("created_at", timestamp).
+		Strs("tags", []string{"a", "b"}).
+		Ints("ids", []int{1, 2, 3}).
+		Bytes("raw", []byte("payload")).
+		Hex("digest", []byte{0xde, 0xad}).
+		IPAddr("ip", net.ParseIP("10.0.0.1")).
+		Stringer("status", testStringer{"active"}).
+		Msg("rich fields")
+
+	fields := adapter.logCalls[0].fields
+
+	expected := map[string]interface{}{
+		"int64":   int64(1 << 40),
+		"uint64":  uint64(1 << 63),
+		"elapsed": 1500 * time.Millisecond,
+		"raw":     "payload",
+		"digest":  "dead",
+		"status":  "active",
+	}
+	for k, v := range expected {
+		if fields[k] != v {
+			t.Errorf("Field %s: expected %v (%T), got %v (%T)", k, v, v, fields[k], fields[k])
+		}
+	}
+
+	if createdAt, ok := fields["created_at"].(time.Time); !ok || !createdAt.Equal(timestamp) {
+		t.Errorf("Expected created_at=%v, got %v", timestamp, fields["created_at"])
+	}
+	if !reflect.DeepEqual(fields["tags"], []string{"a", "b"}) {
+		t.Errorf("Expected tags=[a b], got %v", fields["tags"])
+	}
+	if !reflect.DeepEqual(fields["ids"], []int{1, 2, 3}) {
+		t.Errorf("Expected ids=[1 2 3], got %v", fields["ids"])
+	}
+	if ip, ok := fields["ip"].(net.IP); !ok || !ip.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("Expected ip=10.0.0.1, got %v", fields["ip"])
+	}
+}
+
+func TestLogEvent_Dict(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+
+	NewLogEvent(adapter, ctx, INFO).
+		Dict("request", func(e LogEvent) {
+			e.Str("method", "GET").
+				Int("status", 200).
+				Dict("client", func(e LogEvent) {
+					e.Str("ip", "10.0.0.1")
+				})
+			// Msg em um evento aninhado não deve ter efeito
+			e.Msg("ignored")
+		}).
+		Msg("nested")
+
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+
+	request, ok := adapter.logCalls[0].fields["request"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected request to be a map, got %T", adapter.logCalls[0].fields["request"])
+	}
+	if request["method"] != "GET" || request["status"] != 200 {
+		t.Errorf("Unexpected request fields: %v", request)
+	}
+
+	client, ok := request["client"].(map[string]interface{})
+	if !ok || client["ip"] != "10.0.0.1" {
+		t.Errorf("Expected nested client.ip='10.0.0.1', got %v", request["client"])
+	}
+}
+
+type testOrder struct {
+	ID       string
+	Total    float64
+	Internal string
+}
+
+func (o testOrder) MarshalLogObject(e LogEvent) {
+	e.Str("id", o.ID).Float64("total", o.Total)
+}
+
+type testOrders []testOrder
+
+func (o testOrders) MarshalLogArray(a LogArray) {
+	for _, order := range o {
+		a.Object(order)
+	}
+}
+
+func TestLogEvent_ObjectAndArray(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+
+	order := testOrder{ID: "o-1", Total: 10.5, Internal: "hidden"}
+	NewLogEvent(adapter, ctx, INFO).
+		Object("order", order).
+		Array("orders", testOrders{order, {ID: "o-2", Total: 3}}).
+		Any("via_any", order).
+		Msg("orders")
+
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+	fields := adapter.logCalls[0].fields
+
+	expectedOrder := map[string]interface{}{"id": "o-1", "total": 10.5}
+	if !reflect.DeepEqual(fields["order"], expectedOrder) {
+		t.Errorf("Expected order=%v, got %v", expectedOrder, fields["order"])
+	}
+	// Any deve respeitar a representação definida pelo ObjectMarshaler
+	if !reflect.DeepEqual(fields["via_any"], expectedOrder) {
+		t.Errorf("Expected via_any=%v, got %v", expectedOrder, fields["via_any"])
+	}
+
+	expectedOrders := []interface{}{
+		expectedOrder,
+		map[string]interface{}{"id": "o-2", "total": float64(3)},
+	}
+	if !reflect.DeepEqual(fields["orders"], expectedOrders) {
+		t.Errorf("Expected orders=%v, got %v", expectedOrders, fields["orders"])
+	}
+}
+
+func TestLogEvent_PanicLevel(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+
+	defer func() {
+		r := recover()
+		if r != "boom" {
+			t.Errorf("Expected panic with 'boom', got %v", r)
+		}
+		if len(adapter.logCalls) != 1 || adapter.logCalls[0].level != PANIC {
+			t.Errorf("Expected entry to be logged before panicking, got %v", adapter.logCalls)
+		}
+	}()
+
+	NewLogEvent(adapter, ctx, PANIC).Str("key", "value").Msg("boom")
+	t.Error("Expected Msg to panic")
+}
+
+// logViaHelper simula uma função auxiliar de logging da aplicação
+func logViaHelper(adapter LoggerAdapter, opts *EventOptions) {
+	NewLogEventWithOptions(adapter, context.Background(), INFO, opts).Msg("via helper")
+}
+
+func TestLogEvent_Caller(t *testing.T) {
+	adapter := newMockAdapter()
+	ctx := context.Background()
+
+	opts := DefaultEventOptions().WithCaller(true)
+	NewLogEventWithOptions(adapter, ctx, INFO, &opts).Msg("with caller")
+	NewLogEventWithOptions(adapter, ctx, INFO, &opts).Msgf("with %s", "caller")
+	NewLogEventWithOptions(adapter, ctx, INFO, &opts).Send()
+
+	skipOpts := opts.WithCallerSkip(1)
+	logViaHelper(adapter, &skipOpts)
+
+	NewLogEvent(adapter, ctx, INFO).Msg("without caller")
+
+	if len(adapter.logCalls) != 5 {
+		t.Fatalf("Expected 5 log calls, got %d", len(adapter.logCalls))
+	}
+
+	for i := 0; i < 4; i++ {
+		fields := adapter.logCalls[i].fields
+		caller, _ := fields[CallerKey].(string)
+		if !strings.Contains(caller, "event_test.go:") {
+			t.Errorf("Call %d: expected caller in event_test.go, got %q", i, caller)
+		}
+		function, _ := fields[FunctionKey].(string)
+		if !strings.HasSuffix(function, ".TestLogEvent_Caller") {
+			t.Errorf("Call %d: expected function TestLogEvent_Caller, got %q", i, function)
+		}
+	}
+
+	if _, exists := adapter.logCalls[4].fields[CallerKey]; exists {
+		t.Error("Expected no caller field without options")
+	}
+}
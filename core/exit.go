@@ -0,0 +1,119 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// DefaultShutdownTimeout é o tempo máximo para a execução dos shutdown
+// hooks antes do encerramento do processo por Exit
+const DefaultShutdownTimeout = 5 * time.Second
+
+// ShutdownHook é uma função executada antes do encerramento do processo,
+// como o fechamento de arquivos ou o envio de dados pendentes
+type ShutdownHook func(ctx context.Context) error
+
+// shutdownEntry associa um ShutdownHook ao nome usado nos erros
+type shutdownEntry struct {
+	id   uint64
+	name string
+	hook ShutdownHook
+}
+
+var (
+	exitMu        sync.Mutex
+	exitFunc      = os.Exit
+	shutdownHooks []shutdownEntry
+	shutdownSeq   uint64
+)
+
+// SetExitFunc substitui a função chamada por Exit (padrão: os.Exit) e
+// retorna uma função que restaura a anterior. Permite que testes
+// interceptem eventos FATAL sem encerrar o processo.
+//
+// Exemplo:
+//
+//	var code int
+//	restore := core.SetExitFunc(func(c int) { code = c })
+//	defer restore()
+func SetExitFunc(fn func(code int)) (restore func()) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+
+	previous := exitFunc
+	if fn == nil {
+		fn = os.Exit
+	}
+	exitFunc = fn
+
+	return func() {
+		exitMu.Lock()
+		defer exitMu.Unlock()
+		exitFunc = previous
+	}
+}
+
+// OnShutdown registra um hook executado por RunShutdownHooks e Exit. Os
+// hooks são executados em ordem inversa de registro, como defer, de modo
+// que componentes criados por último sejam encerrados primeiro. Retorna
+// uma função que remove o hook.
+func OnShutdown(name string, hook ShutdownHook) (unregister func()) {
+	exitMu.Lock()
+	defer exitMu.Unlock()
+
+	shutdownSeq++
+	id := shutdownSeq
+	shutdownHooks = append(shutdownHooks, shutdownEntry{id: id, name: name, hook: hook})
+
+	return func() {
+		exitMu.Lock()
+		defer exitMu.Unlock()
+		for i := range shutdownHooks {
+			if shutdownHooks[i].id == id {
+				shutdownHooks = append(shutdownHooks[:i], shutdownHooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// RunShutdownHooks executa e remove todos os hooks registrados, em ordem
+// inversa de registro. Todos os hooks são executados mesmo em caso de
+// erro; os erros são retornados combinados.
+func RunShutdownHooks(ctx context.Context) error {
+	exitMu.Lock()
+	pending := shutdownHooks
+	shutdownHooks = nil
+	exitMu.Unlock()
+
+	var errs []error
+	for i := len(pending) - 1; i >= 0; i-- {
+		if err := pending[i].hook(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown hook %s: %w", pending[i].name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Exit executa os shutdown hooks, limitados a DefaultShutdownTimeout, e
+// chama a função de saída configurada com o código especificado. É usada
+// pelos eventos FATAL após o registro da entrada.
+func Exit(code int) {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultShutdownTimeout)
+	err := RunShutdownHooks(ctx)
+	cancel()
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "logger: %v\n", err)
+	}
+
+	exitMu.Lock()
+	fn := exitFunc
+	exitMu.Unlock()
+
+	fn(code)
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestShutdownHooks_Order(t *testing.T) {
+	var order []string
+	register := func(name string, err error) func() {
+		return OnShutdown(name, func(ctx context.Context) error {
+			order = append(order, name)
+			return err
+		})
+	}
+
+	register("output", nil)
+	register("datadog", errors.New("agent unreachable"))
+	unregister := register("removed", nil)
+	register("async", nil)
+	unregister()
+
+	err := RunShutdownHooks(context.Background())
+
+	expected := []string{"async", "datadog", "output"}
+	if strings.Join(order, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected hooks in reverse order %v, got %v", expected, order)
+	}
+	if err == nil || !strings.Contains(err.Error(), "shutdown hook datadog: agent unreachable") {
+		t.Errorf("Expected datadog hook error, got %v", err)
+	}
+
+	// Hooks são executados apenas uma vez
+	order = nil
+	if err := RunShutdownHooks(context.Background()); err != nil {
+		t.Errorf("Expected no error on second run, got %v", err)
+	}
+	if len(order) != 0 {
+		t.Errorf("Expected hooks to run only once, got %v", order)
+	}
+}
+
+func TestLogEvent_FatalExits(t *testing.T) {
+	adapter := newMockAdapter()
+	adapter.setLevelEnabled(FATAL, false)
+
+	var steps []string
+	restore := SetExitFunc(func(code int) {
+		steps = append(steps, "exit")
+		if code != 1 {
+			t.Errorf("Expected exit code 1, got %d", code)
+		}
+	})
+	defer restore()
+	OnShutdown("flush", func(ctx context.Context) error {
+		steps = append(steps, "flush")
+		return nil
+	})
+
+	NewLogEvent(adapter, context.Background(), FATAL).Msg("disabled fatal")
+
+	if len(adapter.logCalls) != 0 {
+		t.Errorf("Expected no entry with FATAL disabled, got %d", len(adapter.logCalls))
+	}
+	if strings.Join(steps, ",") != "flush,exit" {
+		t.Errorf("Expected shutdown hooks before exit, got %v", steps)
+	}
+
+	adapter.setLevelEnabled(FATAL, true)
+	steps = nil
+	NewLogEvent(adapter, context.Background(), FATAL).Str("reason", "config").Msg("fatal")
+
+	if len(adapter.logCalls) != 1 || adapter.logCalls[0].fields["reason"] != "config" {
+		t.Errorf("Expected FATAL entry to be written before exit, got %v", adapter.logCalls)
+	}
+	if strings.Join(steps, ",") != "exit" {
+		t.Errorf("Expected exit after the entry, got %v", steps)
+	}
+}
+
+func TestLogEvent_DisabledFatalMsgfExits(t *testing.T) {
+	adapter := newMockAdapter()
+	adapter.setLevelEnabled(FATAL, false)
+
+	exits := 0
+	restore := SetExitFunc(func(code int) { exits++ })
+	defer restore()
+
+	NewLogEvent(adapter, context.Background(), FATAL).Msgf("disabled %s", "fatal")
+
+	if len(adapter.logCalls) != 0 {
+		t.Errorf("Expected no entry with FATAL disabled, got %d", len(adapter.logCalls))
+	}
+	if exits != 1 {
+		t.Errorf("Expected Msgf on disabled FATAL to exit once, got %d", exits)
+	}
+}
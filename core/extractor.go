@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// ContextExtractor extrai do contexto o valor de um campo de log. Retorna
+// false quando o valor não está presente.
+type ContextExtractor func(ctx context.Context) (interface{}, bool)
+
+// contextField associa a chave de um campo ao seu extractor
+type contextField struct {
+	key       string
+	extractor ContextExtractor
+}
+
+var (
+	// contextFields contém os extractors registrados, em ordem de registro.
+	// O slice nunca é modificado após publicado, permitindo leituras sem lock.
+	contextFields atomic.Pointer[[]contextField]
+	// contextFieldsMu serializa as alterações do registro
+	contextFieldsMu sync.Mutex
+)
+
+func init() {
+	// Campos padrão, compartilhados pelo Formatter e pelo mapeamento ECS
+	RegisterContextField("trace_id", contextGetter(GetTraceID))
+	RegisterContextField("correlation_id", contextGetter(GetCorrelationID))
+	RegisterContextField("user_id", contextGetter(GetUserID))
+	RegisterContextField("span_id", contextGetter(GetSpanID))
+	RegisterContextField("request_id", contextGetter(GetRequestID))
+	RegisterContextField("session_id", contextGetter(GetSessionID))
+}
+
+// RegisterContextField registra um extractor para o campo key, adicionado
+// a todas as entradas de log cujo contexto contenha o valor. Registrar uma
+// chave existente substitui o seu extractor mantendo a posição original.
+//
+// Exemplo:
+//
+//	core.RegisterContextField("tenant_id", core.ContextString(tenantKey{}))
+func RegisterContextField(key string, extractor ContextExtractor) {
+	if extractor == nil {
+		UnregisterContextField(key)
+		return
+	}
+
+	contextFieldsMu.Lock()
+	defer contextFieldsMu.Unlock()
+
+	current := loadContextFields()
+	updated := make([]contextField, 0, len(current)+1)
+	replaced := false
+	for _, field := range current {
+		if field.key == key {
+			field.extractor = extractor
+			replaced = true
+		}
+		updated = append(updated, field)
+	}
+	if !replaced {
+		updated = append(updated, contextField{key: key, extractor: extractor})
+	}
+	contextFields.Store(&updated)
+}
+
+// UnregisterContextField remove o extractor do campo key, incluindo os
+// campos padrão
+func UnregisterContextField(key string) {
+	contextFieldsMu.Lock()
+	defer contextFieldsMu.Unlock()
+
+	current := loadContextFields()
+	updated := make([]contextField, 0, len(current))
+	for _, field := range current {
+		if field.key != key {
+			updated = append(updated, field)
+		}
+	}
+	contextFields.Store(&updated)
+}
+
+// ContextFieldKeys retorna as chaves dos campos registrados, em ordem de registro
+func ContextFieldKeys() []string {
+	current := loadContextFields()
+	keys := make([]string, len(current))
+	for i, field := range current {
+		keys[i] = field.key
+	}
+	return keys
+}
+
+// AppendContextFields adiciona a dst os campos extraídos do contexto pelos
+// extractors registrados, seguidos dos itens de baggage cujas chaves não
+// foram extraídas
+func AppendContextFields(dst []Field, ctx context.Context) []Field {
+	if ctx == nil {
+		return dst
+	}
+	start := len(dst)
+	for _, field := range loadContextFields() {
+		if val, ok := field.extractor(ctx); ok {
+			dst = append(dst, Any(field.key, val))
+		}
+	}
+
+	baggage, _ := ctx.Value(baggageKey).(map[string]string)
+	for _, key := range baggageKeys(baggage) {
+		if !HasField(dst[start:], key) {
+			dst = append(dst, String(key, baggage[key]))
+		}
+	}
+	return dst
+}
+
+// ExtractContextFields retorna os campos extraídos do contexto pelos
+// extractors registrados e os itens de baggage
+func ExtractContextFields(ctx context.Context) map[string]interface{} {
+	fields := AppendContextFields(nil, ctx)
+	result := make(map[string]interface{}, len(fields))
+	for i := range fields {
+		result[fields[i].Key] = fields[i].Value()
+	}
+	return result
+}
+
+// ContextString retorna um extractor que lê um valor string não vazio
+// armazenado no contexto sob a chave especificada
+func ContextString(key interface{}) ContextExtractor {
+	return func(ctx context.Context) (interface{}, bool) {
+		val, ok := ctx.Value(key).(string)
+		return val, ok && val != ""
+	}
+}
+
+// FirstContextValue retorna um extractor que usa o primeiro valor
+// encontrado entre os extractors especificados
+func FirstContextValue(extractors ...ContextExtractor) ContextExtractor {
+	return func(ctx context.Context) (interface{}, bool) {
+		for _, extractor := range extractors {
+			if val, ok := extractor(ctx); ok {
+				return val, true
+			}
+		}
+		return nil, false
+	}
+}
+
+// contextGetter adapta uma função Get* do pacote para ContextExtractor
+func contextGetter(get func(ctx context.Context) (string, bool)) ContextExtractor {
+	return func(ctx context.Context) (interface{}, bool) {
+		return get(ctx)
+	}
+}
+
+// loadContextFields retorna o slice de extractors registrados
+func loadContextFields() []contextField {
+	if fields := contextFields.Load(); fields != nil {
+		return *fields
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// tenantKey é a chave de contexto usada pelos testes de extractors
+type tenantKey struct{}
+
+// restoreContextFields restaura o registro de extractors ao fim do teste
+func restoreContextFields(t *testing.T) {
+	saved := contextFields.Load()
+	t.Cleanup(func() { contextFields.Store(saved) })
+}
+
+func TestExtractContextFields_Defaults(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "trace_id", "raw-trace")
+	ctx = WithTraceID(ctx, "typed-trace")
+	ctx = context.WithValue(ctx, "request_id", "req-1")
+	ctx = context.WithValue(ctx, "session_id", "")
+	ctx = WithUserID(ctx, "user-1")
+
+	expected := map[string]interface{}{
+		"trace_id":   "typed-trace",
+		"user_id":    "user-1",
+		"request_id": "req-1",
+	}
+	if got := ExtractContextFields(ctx); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	raw := context.WithValue(context.Background(), "correlation_id", "raw-corr")
+	if got := ExtractContextFields(raw)["correlation_id"]; got != "raw-corr" {
+		t.Errorf("Expected raw string key fallback, got %v", got)
+	}
+	if got := AppendContextFields(nil, nil); len(got) != 0 {
+		t.Errorf("Expected no fields for nil context, got %v", got)
+	}
+}
+
+func TestAppendContextFields_DoesNotAllocate(t *testing.T) {
+	ctx := context.Background()
+	buf := make([]Field, 0, 8)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendContextFields(buf[:0], ctx)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected default extractors not to allocate, got %v allocs", allocs)
+	}
+}
+
+func TestRegisterContextField(t *testing.T) {
+	restoreContextFields(t)
+
+	RegisterContextField("tenant_id", ContextString(tenantKey{}))
+	RegisterContextField("trace_id", func(ctx context.Context) (interface{}, bool) {
+		return "custom-trace", true
+	})
+
+	keys := ContextFieldKeys()
+	if keys[0] != "trace_id" || keys[len(keys)-1] != "tenant_id" {
+		t.Errorf("Expected replaced key to keep its position and new key appended, got %v", keys)
+	}
+
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	fields := FieldsToMap(AppendContextFields(nil, ctx))
+	if fields["tenant_id"] != "acme" || fields["trace_id"] != "custom-trace" {
+		t.Errorf("Expected custom extractors to be applied, got %v", fields)
+	}
+
+	formatter := NewFormatter(Config{ServiceName: "svc", Environment: "test"})
+	base := FieldsToMap(formatter.AppendBaseFields(nil, ctx, INFO))
+	if base["tenant_id"] != "acme" {
+		t.Errorf("Expected formatter to include registered context fields, got %v", base)
+	}
+
+	UnregisterContextField("tenant_id")
+	RegisterContextField("trace_id", nil)
+	if fields := ExtractContextFields(ctx); len(fields) != 0 {
+		t.Errorf("Expected unregistered fields to be skipped, got %v", fields)
+	}
+}
@@ -0,0 +1,287 @@
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"time"
+)
+
+// FieldType identifica como o valor de um Field está armazenado
+type FieldType uint8
+
+const (
+	// UnknownType indica um campo não inicializado
+	UnknownType FieldType = iota
+	// StringType indica um campo string armazenado em Field.String
+	StringType
+	// IntType indica um campo int armazenado em Field.Integer
+	IntType
+	// Int64Type indica um campo int64 armazenado em Field.Integer
+	Int64Type
+	// Float64Type indica um campo float64 armazenado em Field.Float
+	Float64Type
+	// BoolType indica um campo booleano armazenado em Field.Integer (0 ou 1)
+	BoolType
+	// AnyType indica um campo de tipo arbitrário armazenado em Field.Interface
+	AnyType
+	// Uint64Type indica um campo uint64 armazenado em Field.Integer
+	Uint64Type
+	// DurationType indica um campo time.Duration armazenado em Field.Integer
+	DurationType
+	// TimeType indica um campo time.Time armazenado em Field.Integer (Unix nanos)
+	// com a localização em Field.Interface
+	TimeType
+	// StringsType indica um campo []string armazenado em Field.Interface
+	StringsType
+	// IntsType indica um campo []int armazenado em Field.Interface
+	IntsType
+	// BytesType indica um campo []byte renderizado como string
+	BytesType
+	// HexType indica um campo []byte renderizado em hexadecimal
+	HexType
+	// IPAddrType indica um campo net.IP armazenado em Field.Interface
+	IPAddrType
+	// StringerType indica um campo fmt.Stringer armazenado em Field.Interface
+	StringerType
+	// DictType indica um objeto aninhado cujos campos ([]Field) estão em Field.Interface
+	DictType
+	// ArrayType indica um array cujos elementos ([]Field sem chave) estão em Field.Interface
+	ArrayType
+	// LazyType indica um valor Lazy armazenado em Field.Interface, calculado
+	// apenas pelo adapter ao escrever a entrada
+	LazyType
+)
+
+// Field representa um campo estruturado tipado de uma entrada de log.
+// Valores primitivos são armazenados sem boxing, permitindo que adapters
+// que implementam TypedLoggerAdapter os encaminhem diretamente para o
+// encoder nativo da biblioteca de logging sem alocações intermediárias.
+type Field struct {
+	Key       string
+	Type      FieldType
+	Integer   int64
+	Float     float64
+	String    string
+	Interface interface{}
+}
+
+// String cria um campo string
+func String(key, val string) Field {
+	return Field{Key: key, Type: StringType, String: val}
+}
+
+// Int cria um campo inteiro
+func Int(key string, val int) Field {
+	return Field{Key: key, Type: IntType, Integer: int64(val)}
+}
+
+// Int64 cria um campo int64
+func Int64(key string, val int64) Field {
+	return Field{Key: key, Type: Int64Type, Integer: val}
+}
+
+// Float64 cria um campo float64
+func Float64(key string, val float64) Field {
+	return Field{Key: key, Type: Float64Type, Float: val}
+}
+
+// Bool cria um campo booleano
+func Bool(key string, val bool) Field {
+	var i int64
+	if val {
+		i = 1
+	}
+	return Field{Key: key, Type: BoolType, Integer: i}
+}
+
+// Uint64 cria um campo uint64
+func Uint64(key string, val uint64) Field {
+	return Field{Key: key, Type: Uint64Type, Integer: int64(val)}
+}
+
+// Duration cria um campo time.Duration
+func Duration(key string, val time.Duration) Field {
+	return Field{Key: key, Type: DurationType, Integer: int64(val)}
+}
+
+// Time cria um campo time.Time. Instantes fora do intervalo representável
+// em nanossegundos Unix são armazenados integralmente em Field.Interface.
+func Time(key string, val time.Time) Field {
+	if year := val.Year(); year < 1678 || year > 2261 {
+		return Field{Key: key, Type: TimeType, Interface: val}
+	}
+	return Field{Key: key, Type: TimeType, Integer: val.UnixNano(), Interface: val.Location()}
+}
+
+// Strings cria um campo []string
+func Strings(key string, vals []string) Field {
+	return Field{Key: key, Type: StringsType, Interface: vals}
+}
+
+// Ints cria um campo []int
+func Ints(key string, vals []int) Field {
+	return Field{Key: key, Type: IntsType, Interface: vals}
+}
+
+// Bytes cria um campo []byte renderizado como string
+func Bytes(key string, val []byte) Field {
+	return Field{Key: key, Type: BytesType, Interface: val}
+}
+
+// Hex cria um campo []byte renderizado em hexadecimal
+func Hex(key string, val []byte) Field {
+	return Field{Key: key, Type: HexType, Interface: val}
+}
+
+// IPAddr cria um campo com um endereço IP
+func IPAddr(key string, ip net.IP) Field {
+	return Field{Key: key, Type: IPAddrType, Interface: ip}
+}
+
+// Stringer cria um campo a partir de um fmt.Stringer
+func Stringer(key string, val fmt.Stringer) Field {
+	return Field{Key: key, Type: StringerType, Interface: val}
+}
+
+// Dict cria um objeto aninhado com os campos especificados
+func Dict(key string, fields ...Field) Field {
+	return Field{Key: key, Type: DictType, Interface: fields}
+}
+
+// Func cria um campo cujo valor é calculado por fn apenas quando a entrada
+// é escrita
+func Func(key string, fn func() interface{}) Field {
+	return Field{Key: key, Type: LazyType, Interface: Lazy(fn)}
+}
+
+// Any cria um campo a partir de um valor arbitrário, escolhendo a
+// representação tipada quando o tipo dinâmico é conhecido
+func Any(key string, val interface{}) Field {
+	switch v := val.(type) {
+	case string:
+		return String(key, v)
+	case int:
+		return Int(key, v)
+	case int64:
+		return Int64(key, v)
+	case float64:
+		return Float64(key, v)
+	case bool:
+		return Bool(key, v)
+	case uint64:
+		return Uint64(key, v)
+	case time.Duration:
+		return Duration(key, v)
+	case time.Time:
+		return Time(key, v)
+	case []string:
+		return Strings(key, v)
+	case []int:
+		return Ints(key, v)
+	case net.IP:
+		return IPAddr(key, v)
+	case ObjectMarshaler:
+		return Object(key, v)
+	case ArrayMarshaler:
+		return Array(key, v)
+	case Lazy:
+		return Field{Key: key, Type: LazyType, Interface: v}
+	default:
+		return Field{Key: key, Type: AnyType, Interface: val}
+	}
+}
+
+// Value retorna o valor do campo na forma em que seria armazenado em um
+// map[string]interface{}, preservando o tipo Go original. Campos LazyType
+// retornam o Lazy sem calculá-lo.
+func (f Field) Value() interface{} {
+	switch f.Type {
+	case StringType:
+		return f.String
+	case IntType:
+		return int(f.Integer)
+	case Int64Type:
+		return f.Integer
+	case Float64Type:
+		return f.Float
+	case BoolType:
+		return f.Integer == 1
+	case Uint64Type:
+		return uint64(f.Integer)
+	case DurationType:
+		return time.Duration(f.Integer)
+	case TimeType:
+		return f.TimeValue()
+	case BytesType:
+		if b, ok := f.Interface.([]byte); ok {
+			return string(b)
+		}
+		return ""
+	case HexType:
+		if b, ok := f.Interface.([]byte); ok {
+			return hex.EncodeToString(b)
+		}
+		return ""
+	case StringerType:
+		if s, ok := f.Interface.(fmt.Stringer); ok && s != nil {
+			return s.String()
+		}
+		return nil
+	case DictType:
+		if fields, ok := f.Interface.([]Field); ok {
+			return FieldsToMap(fields)
+		}
+		return map[string]interface{}{}
+	case ArrayType:
+		fields, _ := f.Interface.([]Field)
+		values := make([]interface{}, len(fields))
+		for i := range fields {
+			values[i] = fields[i].Value()
+		}
+		return values
+	default:
+		return f.Interface
+	}
+}
+
+// TimeValue retorna o valor de um campo TimeType como time.Time
+func (f Field) TimeValue() time.Time {
+	if t, ok := f.Interface.(time.Time); ok {
+		return t
+	}
+	t := time.Unix(0, f.Integer)
+	if loc, ok := f.Interface.(*time.Location); ok && loc != nil {
+		return t.In(loc)
+	}
+	return t.UTC()
+}
+
+// FieldsToMap converte um slice de campos tipados para a representação em map
+// usada por LoggerAdapter.Log. Campos com chaves repetidas mantêm o último valor.
+func FieldsToMap(fields []Field) map[string]interface{} {
+	result := make(map[string]interface{}, len(fields))
+	for i := range fields {
+		result[fields[i].Key] = fields[i].Value()
+	}
+	return result
+}
+
+// FieldsFromMap converte um map de campos para um slice de campos tipados
+func FieldsFromMap(fields map[string]interface{}) []Field {
+	result := make([]Field, 0, len(fields))
+	for k, v := range fields {
+		result = append(result, Any(k, v))
+	}
+	return result
+}
+
+// HasField verifica se existe um campo com a chave especificada
+func HasField(fields []Field, key string) bool {
+	for i := range fields {
+		if fields[i].Key == key {
+			return true
+		}
+	}
+	return false
+}
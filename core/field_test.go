@@ -0,0 +1,81 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestAny_TypedRepresentation(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected FieldType
+	}{
+		{"string", "value", StringType},
+		{"int", 42, IntType},
+		{"int64", int64(42), Int64Type},
+		{"float64", 3.14, Float64Type},
+		{"bool", true, BoolType},
+		{"struct", struct{ Name string }{"John"}, AnyType},
+		{"nil", nil, AnyType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := Any("key", tt.value)
+			if field.Type != tt.expected {
+				t.Errorf("Any(%v).Type = %v, expected %v", tt.value, field.Type, tt.expected)
+			}
+			if field.Value() != tt.value {
+				t.Errorf("Any(%v).Value() = %v, expected original value", tt.value, field.Value())
+			}
+		})
+	}
+}
+
+func TestFieldsToMap(t *testing.T) {
+	fields := []Field{
+		String("name", "John"),
+		Int("age", 30),
+		Int64("id", 123),
+		Float64("score", 9.5),
+		Bool("active", true),
+		Bool("admin", false),
+	}
+
+	result := FieldsToMap(fields)
+
+	expected := map[string]interface{}{
+		"name":   "John",
+		"age":    30,
+		"id":     int64(123),
+		"score":  9.5,
+		"active": true,
+		"admin":  false,
+	}
+
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %d fields, got %d", len(expected), len(result))
+	}
+	for k, v := range expected {
+		if result[k] != v {
+			t.Errorf("Field %s: expected %v (%T), got %v (%T)", k, v, v, result[k], result[k])
+		}
+	}
+}
+
+func TestFieldsFromMap(t *testing.T) {
+	fields := FieldsFromMap(map[string]interface{}{
+		"name": "John",
+		"age":  30,
+	})
+
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %d", len(fields))
+	}
+	if !HasField(fields, "name") || !HasField(fields, "age") {
+		t.Errorf("Expected fields name and age, got %v", fields)
+	}
+	if HasField(fields, "missing") {
+		t.Error("HasField should return false for missing key")
+	}
+}
@@ -2,7 +2,7 @@ package core
 
 import (
 	"context"
-	"encoding/json"
+	"sync/atomic"
 	"time"
 
 	"github.com/victorximenis/logger/sanitize"
@@ -29,22 +29,14 @@ func NewFormatter(config Config) *Formatter {
 // FormatLogEvent formata um evento de log em uma estrutura JSON padronizada
 // incluindo campos base, enriquecimento de contexto e campos customizados
 func (f *Formatter) FormatLogEvent(ctx context.Context, level Level, msg string, fields map[string]interface{}) map[string]interface{} {
-	// Começar com campos base
-	result := map[string]interface{}{
-		"timestamp": time.Now().UTC().Format(time.RFC3339),
-		"level":     level.String(),
-		"service":   f.config.ServiceName,
-		"env":       f.config.Environment,
-		"message":   msg,
-	}
+	// Começar com campos base e valores do contexto
+	base := f.AppendBaseFields(make([]Field, 0, 8), ctx, level)
 
-	// Adicionar tenant se disponível
-	if f.config.TenantID != "" {
-		result["tenant"] = f.config.TenantID
+	result := make(map[string]interface{}, len(base)+len(fields)+1)
+	for i := range base {
+		result[base[i].Key] = base[i].Value()
 	}
-
-	// Adicionar valores do contexto
-	result = f.enrichFromContext(ctx, result)
+	result["message"] = msg
 
 	// Adicionar campos customizados
 	for k, v := range fields {
@@ -59,49 +51,55 @@ func (f *Formatter) FormatLogEvent(ctx context.Context, level Level, msg string,
 	return result
 }
 
-// enrichFromContext extrai valores do contexto e os adiciona aos campos do log
-func (f *Formatter) enrichFromContext(ctx context.Context, fields map[string]interface{}) map[string]interface{} {
-	// Extrair e adicionar trace ID se presente
-	if traceID, ok := ctx.Value(traceIDKey).(string); ok && traceID != "" {
-		fields["trace_id"] = traceID
-	}
+// AppendBaseFields adiciona a dst os campos base (timestamp, level, service,
+// env e tenant) e os valores extraídos do contexto, na mesma forma produzida
+// por FormatLogEvent. A mensagem não é incluída.
+func (f *Formatter) AppendBaseFields(dst []Field, ctx context.Context, level Level) []Field {
+	dst = append(dst,
+		String("timestamp", currentTimestamp()),
+		String("level", level.String()),
+		String("service", f.config.ServiceName),
+		String("env", f.config.Environment),
+	)
 
-	// Extrair e adicionar correlation ID se presente
-	if correlationID, ok := ctx.Value(correlationIDKey).(string); ok && correlationID != "" {
-		fields["correlation_id"] = correlationID
+	// Adicionar tenant se disponível
+	if f.config.TenantID != "" {
+		dst = append(dst, String("tenant", f.config.TenantID))
 	}
 
-	// Extrair e adicionar user ID se presente
-	if userID, ok := ctx.Value(userIDKey).(string); ok && userID != "" {
-		fields["user_id"] = userID
-	}
+	// Adicionar valores do contexto via extractors registrados
+	return AppendContextFields(dst, ctx)
+}
 
-	return fields
+// RequiresMap informa se a formatação depende da representação em map dos
+// campos, como ocorre quando a sanitização de dados sensíveis está habilitada
+func (f *Formatter) RequiresMap() bool {
+	return f.config.SanitizeSensitiveData
 }
 
-// sanitizeFields aplica sanitização aos campos do log usando as regras padrão
-func (f *Formatter) sanitizeFields(fields map[string]interface{}) map[string]interface{} {
-	sanitizeConfig := sanitize.DefaultSensitiveFieldConfig()
-
-	// Converter para JSON e sanitizar
-	if jsonData, err := json.Marshal(fields); err == nil {
-		if sanitizedData, err := sanitize.SanitizeJSON(jsonData, sanitizeConfig); err == nil {
-			var sanitizedFields map[string]interface{}
-			if err := json.Unmarshal(sanitizedData, &sanitizedFields); err == nil {
-				return sanitizedFields
-			}
-		}
-	}
+// cachedTimestamp guarda o timestamp formatado do segundo corrente
+type cachedTimestamp struct {
+	unix      int64
+	formatted string
+}
 
-	// Fallback: sanitizar strings individuais se a sanitização JSON falhar
-	result := make(map[string]interface{})
-	for k, v := range fields {
-		if str, ok := v.(string); ok {
-			result[k] = sanitize.SanitizeString(str, sanitizeConfig)
-		} else {
-			result[k] = v
-		}
+// timestampCache evita formatar o timestamp a cada evento, já que o formato
+// RFC3339 tem resolução de segundos
+var timestampCache atomic.Pointer[cachedTimestamp]
+
+// currentTimestamp retorna o horário atual em UTC no formato RFC3339
+func currentTimestamp() string {
+	now := time.Now().UTC()
+	if cached := timestampCache.Load(); cached != nil && cached.unix == now.Unix() {
+		return cached.formatted
 	}
 
-	return result
+	formatted := now.Format(time.RFC3339)
+	timestampCache.Store(&cachedTimestamp{unix: now.Unix(), formatted: formatted})
+	return formatted
+}
+
+// sanitizeFields aplica sanitização aos campos do log usando as regras padrão
+func (f *Formatter) sanitizeFields(fields map[string]interface{}) map[string]interface{} {
+	return sanitizeFieldMap(fields, sanitize.DefaultSensitiveFieldConfig())
 }
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+
+	"github.com/victorximenis/logger/sanitize"
+)
+
+// Entry é a entrada de log entregue aos hooks. Os hooks podem alterar o
+// nível, a mensagem e os campos antes da escrita pelo adapter.
+type Entry struct {
+	// Context é o contexto da chamada de log
+	Context context.Context
+	// Level é o nível da entrada
+	Level Level
+	// Message é a mensagem da entrada
+	Message string
+	// Fields contém os campos da entrada e pode ser modificado diretamente
+	Fields map[string]interface{}
+}
+
+// Hook processa entradas de log antes da escrita. Process pode modificar a
+// entrada e retorna false para descartá-la, interrompendo os hooks seguintes.
+type Hook interface {
+	Process(entry *Entry) bool
+}
+
+// HookFunc permite usar uma função comum como Hook
+type HookFunc func(entry *Entry) bool
+
+// Process implementa a interface Hook
+func (f HookFunc) Process(entry *Entry) bool {
+	return f(entry)
+}
+
+// RunHooks executa os hooks em ordem sobre a entrada. Retorna false se
+// algum hook descartar a entrada.
+func RunHooks(entry *Entry, hooks []Hook) bool {
+	for _, hook := range hooks {
+		if !hook.Process(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+// HookableAdapter é implementado por adapters capazes de executar hooks
+// após a formatação da entrada, quando os campos base (timestamp, level,
+// service...), os do contexto e os de enriquecimento já estão presentes.
+// Decorators implementam a interface repassando os hooks ao adapter decorado.
+type HookableAdapter interface {
+	LoggerAdapter
+
+	// WithHooks retorna uma cópia do adapter que executa os hooks
+	// especificados, em ordem, após os já configurados
+	WithHooks(hooks ...Hook) LoggerAdapter
+}
+
+// WithAdapterHooks adiciona hooks ao adapter. Quando o adapter implementa
+// HookableAdapter, os hooks são executados após a formatação; caso
+// contrário, o adapter é envolvido por um HookAdapter, cujos hooks recebem
+// apenas os campos do evento.
+func WithAdapterHooks(adapter LoggerAdapter, hooks ...Hook) LoggerAdapter {
+	if len(hooks) == 0 {
+		return adapter
+	}
+	if hookable, ok := adapter.(HookableAdapter); ok {
+		return hookable.WithHooks(hooks...)
+	}
+	return NewHookAdapter(adapter, hooks...)
+}
+
+// HookAdapter é um decorator que executa hooks sobre cada entrada antes de
+// encaminhá-la ao adapter decorado, para adapters que não implementam
+// HookableAdapter. Os hooks recebem uma cópia dos campos do evento, sem os
+// campos adicionados na formatação. Apenas entradas com nível habilitado
+// chegam aos hooks; entradas FATAL e PANIC descartadas por um hook ainda
+// encerram o processo ou disparam o panic.
+type HookAdapter struct {
+	LoggerAdapter
+	hooks []Hook
+}
+
+// NewHookAdapter cria um HookAdapter que executa os hooks em ordem. Quando
+// adapter já é um HookAdapter, os hooks são adicionados após os existentes
+// em um novo adapter, sem modificar o original.
+func NewHookAdapter(adapter LoggerAdapter, hooks ...Hook) *HookAdapter {
+	if existing, ok := adapter.(*HookAdapter); ok {
+		combined := make([]Hook, 0, len(existing.hooks)+len(hooks))
+		combined = append(combined, existing.hooks...)
+		combined = append(combined, hooks...)
+		return &HookAdapter{LoggerAdapter: existing.LoggerAdapter, hooks: combined}
+	}
+	return &HookAdapter{LoggerAdapter: adapter, hooks: hooks}
+}
+
+// Log implementa a interface LoggerAdapter executando os hooks antes da escrita
+func (h *HookAdapter) Log(ctx context.Context, level Level, msg string, fields map[string]interface{}) {
+	// Os hooks operam sobre uma cópia, preservando o map do chamador
+	entry := Entry{Context: ctx, Level: level, Message: msg, Fields: copyFieldMap(fields)}
+
+	if !RunHooks(&entry, h.hooks) {
+		return
+	}
+	h.LoggerAdapter.Log(entry.Context, entry.Level, entry.Message, entry.Fields)
+}
+
+// WithContext implementa a interface LoggerAdapter
+func (h *HookAdapter) WithContext(ctx context.Context) LoggerAdapter {
+	return &HookAdapter{
+		LoggerAdapter: h.LoggerAdapter.WithContext(ctx),
+		hooks:         h.hooks,
+	}
+}
+
+// IsComponentLevelEnabled implementa a interface ComponentLevelEnabler
+func (h *HookAdapter) IsComponentLevelEnabled(component string, level Level) bool {
+	return IsComponentLevelEnabled(h.LoggerAdapter, component, level)
+}
+
+// Flush implementa a interface Flusher
+func (h *HookAdapter) Flush(ctx context.Context) error {
+	return FlushAdapter(ctx, h.LoggerAdapter)
+}
+
+// Close implementa a interface io.Closer
+func (h *HookAdapter) Close() error {
+	return CloseAdapter(h.LoggerAdapter)
+}
+
+// NewSanitizeHook cria um hook que mascara dados sensíveis na mensagem e
+// nos campos da entrada de acordo com config, com as mesmas regras usadas
+// pelo Formatter quando SanitizeSensitiveData está habilitado
+func NewSanitizeHook(config sanitize.SensitiveFieldConfig) Hook {
+	return HookFunc(func(entry *Entry) bool {
+		entry.Message = sanitize.SanitizeString(entry.Message, config)
+		entry.Fields = sanitizeFieldMap(entry.Fields, config)
+		return true
+	})
+}
+
+// sanitizeFieldMap aplica a sanitização aos campos de log. Valores Lazy
+// são calculados antes, para que o resultado também seja sanitizado.
+func sanitizeFieldMap(fields map[string]interface{}, config sanitize.SensitiveFieldConfig) map[string]interface{} {
+	resolved, copied := fields, false
+	for k, v := range fields {
+		if lazy, ok := v.(Lazy); ok {
+			if !copied {
+				resolved, copied = copyFieldMap(fields), true
+			}
+			resolved[k] = lazy.Resolve()
+		}
+	}
+	return sanitize.SanitizeFields(resolved, config)
+}
@@ -0,0 +1,177 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/victorximenis/logger/sanitize"
+)
+
+func TestHookAdapter_Pipeline(t *testing.T) {
+	base := newMockAdapter()
+	var order []string
+
+	addField := HookFunc(func(entry *Entry) bool {
+		order = append(order, "add")
+		entry.Fields["region"] = "sa-east-1"
+		delete(entry.Fields, "internal")
+		return true
+	})
+	rewrite := HookFunc(func(entry *Entry) bool {
+		order = append(order, "rewrite")
+		entry.Message = strings.ToUpper(entry.Message)
+		if entry.Fields["escalate"] == true {
+			entry.Level = ERROR
+		}
+		return true
+	})
+	veto := HookFunc(func(entry *Entry) bool {
+		order = append(order, "veto")
+		return entry.Fields["healthcheck"] != true
+	})
+
+	adapter := NewHookAdapter(base, addField, rewrite)
+	adapter = NewHookAdapter(adapter, veto)
+	ctx := context.Background()
+
+	adapter.Log(ctx, INFO, "payment failed", map[string]interface{}{"internal": "x", "escalate": true})
+
+	if strings.Join(order, ",") != "add,rewrite,veto" {
+		t.Errorf("Expected hooks to run in registration order, got %v", order)
+	}
+	if len(base.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(base.logCalls))
+	}
+	call := base.logCalls[0]
+	if call.level != ERROR || call.msg != "PAYMENT FAILED" {
+		t.Errorf("Expected rewritten ERROR entry, got %v %q", call.level, call.msg)
+	}
+	if call.fields["region"] != "sa-east-1" {
+		t.Errorf("Expected region field to be added, got %v", call.fields)
+	}
+	if _, exists := call.fields["internal"]; exists {
+		t.Errorf("Expected internal field to be removed, got %v", call.fields)
+	}
+
+	// Hooks que retornam false descartam a entrada
+	order = nil
+	adapter.Log(ctx, INFO, "health", map[string]interface{}{"healthcheck": true})
+	if len(base.logCalls) != 1 {
+		t.Errorf("Expected vetoed entry not to be written, got %d calls", len(base.logCalls))
+	}
+
+	// Entradas sem campos recebem um map para os hooks
+	adapter.Log(ctx, INFO, "no fields", nil)
+	if len(base.logCalls) != 2 || base.logCalls[1].fields["region"] != "sa-east-1" {
+		t.Errorf("Expected hooks to add fields to entries without fields, got %v", base.logCalls)
+	}
+
+	// WithContext preserva os hooks
+	withCtx := adapter.WithContext(ctx)
+	if _, ok := withCtx.(*HookAdapter); !ok {
+		t.Errorf("Expected WithContext to return *HookAdapter, got %T", withCtx)
+	}
+}
+
+func TestHookAdapter_WithLogEvent(t *testing.T) {
+	base := newMockAdapter()
+	adapter := NewHookAdapter(base, HookFunc(func(entry *Entry) bool {
+		entry.Fields["hooked"] = true
+		return true
+	}))
+
+	NewLogEvent(adapter, context.Background(), INFO).Str("key", "value").Msg("event")
+
+	if len(base.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(base.logCalls))
+	}
+	fields := base.logCalls[0].fields
+	if fields["key"] != "value" || fields["hooked"] != true {
+		t.Errorf("Expected event and hook fields, got %v", fields)
+	}
+}
+
+func TestHookAdapter_DoesNotMutateFields(t *testing.T) {
+	base := newMockAdapter()
+	adapter := NewHookAdapter(base, HookFunc(func(entry *Entry) bool {
+		entry.Fields["hooked"] = true
+		delete(entry.Fields, "key")
+		return true
+	}))
+
+	fields := map[string]interface{}{"key": "value"}
+	adapter.Log(context.Background(), INFO, "event", fields)
+
+	if len(fields) != 1 || fields["key"] != "value" {
+		t.Errorf("Expected caller fields to be preserved, got %v", fields)
+	}
+	if base.logCalls[0].fields["hooked"] != true {
+		t.Errorf("Expected hook changes to be forwarded, got %v", base.logCalls[0].fields)
+	}
+}
+
+// hookableMockAdapter registra os hooks recebidos via WithHooks
+type hookableMockAdapter struct {
+	*mockAdapter
+	hooks []Hook
+}
+
+func (h *hookableMockAdapter) WithHooks(hooks ...Hook) LoggerAdapter {
+	return &hookableMockAdapter{mockAdapter: h.mockAdapter, hooks: append(h.hooks, hooks...)}
+}
+
+func TestWithAdapterHooks(t *testing.T) {
+	hook := HookFunc(func(entry *Entry) bool { return true })
+
+	base := newMockAdapter()
+	if WithAdapterHooks(base) != LoggerAdapter(base) {
+		t.Error("Expected adapter to be returned unchanged without hooks")
+	}
+	if _, ok := WithAdapterHooks(base, hook).(*HookAdapter); !ok {
+		t.Error("Expected non-hookable adapter to be wrapped by HookAdapter")
+	}
+
+	hookable := &hookableMockAdapter{mockAdapter: base}
+	result, ok := WithAdapterHooks(hookable, hook).(*hookableMockAdapter)
+	if !ok || len(result.hooks) != 1 {
+		t.Errorf("Expected hooks to be delegated to HookableAdapter, got %T", result)
+	}
+
+	// Decorators repassam os hooks ao adapter decorado
+	dedup := NewDedupAdapter(hookable, DefaultDedupConfig())
+	defer dedup.Close()
+	decorated, ok := WithAdapterHooks(dedup, hook).(*DedupAdapter)
+	if !ok {
+		t.Fatalf("Expected *DedupAdapter, got %T", decorated)
+	}
+	if inner, ok := decorated.LoggerAdapter.(*hookableMockAdapter); !ok || len(inner.hooks) != 1 {
+		t.Errorf("Expected DedupAdapter to forward hooks, got %T", decorated.LoggerAdapter)
+	}
+}
+
+func TestSanitizeHook(t *testing.T) {
+	hook := NewSanitizeHook(sanitize.DefaultSensitiveFieldConfig())
+	entry := &Entry{
+		Context: context.Background(),
+		Level:   INFO,
+		Message: "login for joao@example.com",
+		Fields: map[string]interface{}{
+			"password": "hunter2",
+			"order_id": "A-1",
+		},
+	}
+
+	if !hook.Process(entry) {
+		t.Fatal("Expected sanitize hook to keep the entry")
+	}
+	if strings.Contains(entry.Message, "joao@example.com") {
+		t.Errorf("Expected email in message to be masked, got %q", entry.Message)
+	}
+	if entry.Fields["password"] != "***" {
+		t.Errorf("Expected password to be masked, got %v", entry.Fields["password"])
+	}
+	if entry.Fields["order_id"] != "A-1" {
+		t.Errorf("Expected order_id to be preserved, got %v", entry.Fields["order_id"])
+	}
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Lazy é um valor de campo calculado apenas quando a entrada é escrita,
+// após a verificação de nível, a amostragem e os hooks. Pode ser usado em
+// LogEvent.Any, LogEvent.Fields e nos campos pré-definidos de
+// Logger.WithFields, sendo calculado a cada entrada escrita. A função pode
+// ser chamada mais de uma vez quando a entrada é escrita por mais de um
+// adapter e deve ser segura para uso concorrente.
+//
+// Exemplo:
+//
+//	logger.Debug(ctx).
+//		Any("request", core.Lazy(func() interface{} { return snapshot(req) })).
+//		Msg("Request received")
+type Lazy func() interface{}
+
+// Resolve calcula o valor. Um panic na função é recuperado e o valor
+// passa a descrevê-lo, evitando que a escrita da entrada seja interrompida.
+func (l Lazy) Resolve() (value interface{}) {
+	if l == nil {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			value = fmt.Sprintf("lazy value panicked: %v", r)
+		}
+	}()
+	return l()
+}
+
+// MarshalJSON implementa a interface json.Marshaler com o valor calculado
+func (l Lazy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Resolve())
+}
+
+// String implementa a interface fmt.Stringer com o valor calculado
+func (l Lazy) String() string {
+	return fmt.Sprint(l.Resolve())
+}
+
+// ResolveLazy retorna o valor calculado quando val é Lazy, ou o próprio val.
+// Deve ser usado por adapters e hooks que precisam do valor de um campo.
+func ResolveLazy(val interface{}) interface{} {
+	if lazy, ok := val.(Lazy); ok {
+		return lazy.Resolve()
+	}
+	return val
+}
+
+// Func adiciona um campo cujo valor é calculado por fn apenas quando a
+// entrada é escrita
+func (e *logEvent) Func(key string, fn func() interface{}) LogEvent {
+	e.appendField(Func(key, fn))
+	return e
+}
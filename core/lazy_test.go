@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	calls := 0
+	lazy := Lazy(func() interface{} {
+		calls++
+		return map[string]interface{}{"size": 42}
+	})
+
+	field := Any("snapshot", lazy)
+	if field.Type != LazyType {
+		t.Fatalf("Expected LazyType, got %v", field.Type)
+	}
+	if _, ok := field.Value().(Lazy); !ok || calls != 0 {
+		t.Errorf("Expected Value to return the unresolved Lazy, got %T after %d calls", field.Value(), calls)
+	}
+
+	data, err := json.Marshal(FieldsToMap([]Field{field}))
+	if err != nil || string(data) != `{"snapshot":{"size":42}}` {
+		t.Errorf("Expected JSON encoding to resolve the value, got %s (%v)", data, err)
+	}
+	if ResolveLazy("plain") != "plain" {
+		t.Error("Expected ResolveLazy to return non-lazy values unchanged")
+	}
+
+	panicking := Lazy(func() interface{} { panic("boom") })
+	if got := panicking.Resolve(); got != "lazy value panicked: boom" {
+		t.Errorf("Expected panic to be recovered, got %v", got)
+	}
+}
+
+func TestLogEvent_Func(t *testing.T) {
+	adapter := newMockAdapter()
+	adapter.setLevelEnabled(DEBUG, false)
+	calls := 0
+	fn := func() interface{} {
+		calls++
+		return "expensive"
+	}
+
+	NewLogEvent(adapter, context.Background(), DEBUG).Func("payload", fn).Msg("disabled")
+	DisabledEvent().Func("payload", fn).Msg("disabled")
+	NewLogEvent(adapter, context.Background(), INFO).Func("payload", fn).Msg("enabled")
+
+	if calls != 0 {
+		t.Errorf("Expected fn to be left to the adapter, got %d calls", calls)
+	}
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+	if got := ResolveLazy(adapter.logCalls[0].fields["payload"]); got != "expensive" || calls != 1 {
+		t.Errorf("Expected lazy payload to resolve to 'expensive', got %v", got)
+	}
+}
@@ -0,0 +1,313 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// AtomicLevel é um nível de log que pode ser alterado em tempo de execução
+// de forma segura entre goroutines. Adapters e loggers que compartilham a
+// mesma instância passam a respeitar o novo nível imediatamente.
+//
+// Exemplo:
+//
+//	level := core.NewAtomicLevel(core.INFO)
+//	adapter := adapters.NewZerologAdapter(&adapters.ZerologConfig{AtomicLevel: level})
+//	level.SetLevel(core.DEBUG) // efeito imediato em todos os loggers derivados
+type AtomicLevel struct {
+	level atomic.Int32
+}
+
+// NewAtomicLevel cria um AtomicLevel inicializado com o nível especificado
+func NewAtomicLevel(level Level) *AtomicLevel {
+	a := &AtomicLevel{}
+	a.SetLevel(level)
+	return a
+}
+
+// Level retorna o nível atual
+func (a *AtomicLevel) Level() Level {
+	return Level(a.level.Load())
+}
+
+// SetLevel altera o nível atual
+func (a *AtomicLevel) SetLevel(level Level) {
+	a.level.Store(int32(level))
+}
+
+// Enabled verifica se o nível especificado está habilitado
+func (a *AtomicLevel) Enabled(level Level) bool {
+	return level >= a.Level()
+}
+
+// String retorna a representação em string do nível atual
+func (a *AtomicLevel) String() string {
+	return a.Level().String()
+}
+
+// ParseLevel converte uma string para Level. A comparação não diferencia
+// maiúsculas de minúsculas, aceita "WARNING" como sinônimo de "WARN" e
+// reconhece os níveis registrados via RegisterLevel.
+func ParseLevel(s string) (Level, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	switch name {
+	case "TRACE":
+		return TRACE, nil
+	case "DEBUG":
+		return DEBUG, nil
+	case "INFO":
+		return INFO, nil
+	case "WARN", "WARNING":
+		return WARN, nil
+	case "ERROR":
+		return ERROR, nil
+	case "FATAL":
+		return FATAL, nil
+	case "PANIC":
+		return PANIC, nil
+	}
+
+	customLevels.RLock()
+	level, ok := customLevels.byName[name]
+	customLevels.RUnlock()
+	if ok {
+		return level, nil
+	}
+	return INFO, fmt.Errorf("invalid log level: %q", s)
+}
+
+// IsValid verifica se o nível corresponde a um nível padrão ou registrado
+func (l Level) IsValid() bool {
+	if l.isBuiltin() {
+		return true
+	}
+	_, ok := customLevelName(l)
+	return ok
+}
+
+// isBuiltin verifica se o nível é um dos níveis padrão
+func (l Level) isBuiltin() bool {
+	switch l {
+	case TRACE, DEBUG, INFO, WARN, ERROR, FATAL, PANIC:
+		return true
+	default:
+		return false
+	}
+}
+
+// BuiltinLevel retorna o nível padrão mais severo que não excede l, usado
+// por adapters para mapear níveis customizados para os níveis nativos da
+// biblioteca de logging. Níveis abaixo de TRACE retornam TRACE.
+func (l Level) BuiltinLevel() Level {
+	builtin := []Level{PANIC, FATAL, ERROR, WARN, INFO, DEBUG}
+	for _, b := range builtin {
+		if l >= b {
+			return b
+		}
+	}
+	return TRACE
+}
+
+// customLevels armazena os níveis registrados via RegisterLevel
+var customLevels = struct {
+	sync.RWMutex
+	byName  map[string]Level
+	byLevel map[Level]string
+}{
+	byName:  make(map[string]Level),
+	byLevel: make(map[Level]string),
+}
+
+// RegisterLevel registra um nível customizado com o nome e a severidade
+// especificados. O nome é normalizado em maiúsculas e passa a ser aceito por
+// ParseLevel, LOGGER_LOG_LEVEL e Config.Validate. A severidade define a
+// posição do nível em relação aos níveis padrão (ex: entre INFO=0 e WARN=4).
+//
+// Exemplo:
+//
+//	var NOTICE = core.MustRegisterLevel("NOTICE", 2)
+//	var AUDIT = core.MustRegisterLevel("AUDIT", 10)
+func RegisterLevel(name string, severity int) (Level, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	level := Level(severity)
+
+	if name == "" {
+		return level, fmt.Errorf("level name cannot be empty")
+	}
+	if level.isBuiltin() {
+		return level, fmt.Errorf("severity %d is already used by level %s", severity, level)
+	}
+	if builtin, err := ParseLevel(name); err == nil && builtin.isBuiltin() {
+		return level, fmt.Errorf("level name %s is reserved", name)
+	}
+
+	customLevels.Lock()
+	defer customLevels.Unlock()
+
+	if existing, ok := customLevels.byName[name]; ok {
+		if existing == level {
+			return level, nil
+		}
+		return level, fmt.Errorf("level %s is already registered with severity %d", name, int(existing))
+	}
+	if existing, ok := customLevels.byLevel[level]; ok {
+		return level, fmt.Errorf("severity %d is already used by level %s", severity, existing)
+	}
+
+	customLevels.byName[name] = level
+	customLevels.byLevel[level] = name
+	return level, nil
+}
+
+// MustRegisterLevel é como RegisterLevel, mas dispara um panic em caso de erro.
+// Destinado à inicialização de variáveis de pacote.
+func MustRegisterLevel(name string, severity int) Level {
+	level, err := RegisterLevel(name, severity)
+	if err != nil {
+		panic(err)
+	}
+	return level
+}
+
+// customLevelName retorna o nome de um nível registrado via RegisterLevel
+func customLevelName(level Level) (string, bool) {
+	customLevels.RLock()
+	defer customLevels.RUnlock()
+
+	name, ok := customLevels.byLevel[level]
+	return name, ok
+}
+
+// ComponentKey é a chave do campo que identifica o componente de origem de
+// uma entrada de log, usada para resolver overrides de nível por componente
+const ComponentKey = "component"
+
+// ecsComponentKey é a chave usada para o componente após o mapeamento ECS
+const ecsComponentKey = "labels.component"
+
+// ComponentFromFields retorna o componente de origem de uma entrada de log,
+// considerando também a chave resultante do mapeamento ECS
+func ComponentFromFields(fields map[string]interface{}) string {
+	if component, ok := fields[ComponentKey].(string); ok {
+		return component
+	}
+	component, _ := fields[ecsComponentKey].(string)
+	return component
+}
+
+// ComponentFromTypedFields retorna o componente de uma entrada a partir dos
+// seus campos tipados, com as mesmas chaves de ComponentFromFields
+func ComponentFromTypedFields(fields []Field) string {
+	component := ""
+	for i := range fields {
+		if fields[i].Type != StringType {
+			continue
+		}
+		switch fields[i].Key {
+		case ComponentKey:
+			return fields[i].String
+		case ecsComponentKey:
+			component = fields[i].String
+		}
+	}
+	return component
+}
+
+// LevelRegistry mantém o nível global e overrides de nível por componente,
+// todos alteráveis em tempo de execução. Adapters configurados com o mesmo
+// registry filtram cada entrada pelo nível do seu componente (campo
+// "component"), usando o nível global quando não há override.
+type LevelRegistry struct {
+	global     *AtomicLevel
+	mu         sync.RWMutex
+	components map[string]Level
+	// hasOverrides indica se há overrides, permitindo que Enabled use o
+	// nível global sem adquirir o lock quando não há nenhum
+	hasOverrides atomic.Bool
+}
+
+// NewLevelRegistry cria um LevelRegistry usando global como nível padrão.
+// Se global for nil, um novo AtomicLevel com nível INFO é criado.
+func NewLevelRegistry(global *AtomicLevel) *LevelRegistry {
+	if global == nil {
+		global = NewAtomicLevel(INFO)
+	}
+	return &LevelRegistry{
+		global:     global,
+		components: make(map[string]Level),
+	}
+}
+
+// Global retorna o AtomicLevel global do registry
+func (r *LevelRegistry) Global() *AtomicLevel {
+	return r.global
+}
+
+// Level retorna o nível global atual
+func (r *LevelRegistry) Level() Level {
+	return r.global.Level()
+}
+
+// SetLevel altera o nível global
+func (r *LevelRegistry) SetLevel(level Level) {
+	r.global.SetLevel(level)
+}
+
+// ComponentLevel retorna o nível efetivo do componente e se existe um
+// override específico para ele
+func (r *LevelRegistry) ComponentLevel(component string) (Level, bool) {
+	r.mu.RLock()
+	level, ok := r.components[component]
+	r.mu.RUnlock()
+	if !ok {
+		return r.global.Level(), false
+	}
+	return level, true
+}
+
+// SetComponentLevel define um override de nível para o componente
+func (r *LevelRegistry) SetComponentLevel(component string, level Level) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.components[component] = level
+	r.hasOverrides.Store(true)
+}
+
+// RemoveComponentLevel remove o override do componente, que volta a usar o
+// nível global. Retorna false se não havia override.
+func (r *LevelRegistry) RemoveComponentLevel(component string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.components[component]; !ok {
+		return false
+	}
+	delete(r.components, component)
+	r.hasOverrides.Store(len(r.components) > 0)
+	return true
+}
+
+// ComponentLevels retorna uma cópia dos overrides por componente
+func (r *LevelRegistry) ComponentLevels() map[string]Level {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	levels := make(map[string]Level, len(r.components))
+	for component, level := range r.components {
+		levels[component] = level
+	}
+	return levels
+}
+
+// Enabled verifica se o nível está habilitado para o componente.
+// Um componente vazio usa o nível global.
+func (r *LevelRegistry) Enabled(component string, level Level) bool {
+	if component == "" || !r.hasOverrides.Load() {
+		return r.global.Enabled(level)
+	}
+	threshold, _ := r.ComponentLevel(component)
+	return level >= threshold
+}
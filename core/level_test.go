@@ -0,0 +1,177 @@
+package core
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicLevel(t *testing.T) {
+	level := NewAtomicLevel(INFO)
+
+	if level.Level() != INFO {
+		t.Errorf("Expected INFO, got %s", level.Level())
+	}
+	if level.Enabled(DEBUG) {
+		t.Error("Expected DEBUG to be disabled")
+	}
+	if !level.Enabled(WARN) {
+		t.Error("Expected WARN to be enabled")
+	}
+
+	level.SetLevel(DEBUG)
+	if !level.Enabled(DEBUG) {
+		t.Error("Expected DEBUG to be enabled after SetLevel")
+	}
+	if level.String() != "DEBUG" {
+		t.Errorf("Expected 'DEBUG', got %s", level.String())
+	}
+}
+
+func TestAtomicLevel_Concurrent(t *testing.T) {
+	level := NewAtomicLevel(INFO)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			level.SetLevel(Level(i % 5))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = level.Enabled(WARN)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		input     string
+		expected  Level
+		expectErr bool
+	}{
+		{"debug", DEBUG, false},
+		{"INFO", INFO, false},
+		{"Warning", WARN, false},
+		{" error ", ERROR, false},
+		{"fatal", FATAL, false},
+		{"trace", TRACE, false},
+		{"PANIC", PANIC, false},
+		{"verbose", INFO, true},
+		{"", INFO, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			level, err := ParseLevel(tt.input)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("Expected error=%v, got %v", tt.expectErr, err)
+			}
+			if level != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, level)
+			}
+		})
+	}
+}
+
+func TestLevelRegistry(t *testing.T) {
+	registry := NewLevelRegistry(NewAtomicLevel(INFO))
+
+	if registry.Enabled("pgx", DEBUG) {
+		t.Error("Expected DEBUG to be disabled without override")
+	}
+
+	registry.SetComponentLevel("pgx", WARN)
+	registry.SetComponentLevel("http_middleware", DEBUG)
+
+	if registry.Enabled("pgx", INFO) {
+		t.Error("Expected INFO to be disabled for pgx")
+	}
+	if !registry.Enabled("http_middleware", DEBUG) {
+		t.Error("Expected DEBUG to be enabled for http_middleware")
+	}
+	if registry.Enabled("other", DEBUG) || !registry.Enabled("other", INFO) {
+		t.Error("Expected components without override to use the global level")
+	}
+
+	if !registry.RemoveComponentLevel("http_middleware") {
+		t.Error("Expected override to be removed")
+	}
+	if registry.RemoveComponentLevel("http_middleware") {
+		t.Error("Expected false when removing a missing override")
+	}
+	if registry.Enabled("http_middleware", DEBUG) {
+		t.Error("Expected DEBUG to be disabled after removing the override")
+	}
+
+	levels := registry.ComponentLevels()
+	if len(levels) != 1 || levels["pgx"] != WARN {
+		t.Errorf("Expected only pgx=WARN, got %v", levels)
+	}
+}
+
+func TestRegisterLevel(t *testing.T) {
+	notice, err := RegisterLevel("notice", 2)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if notice.String() != "NOTICE" {
+		t.Errorf("Expected 'NOTICE', got %s", notice.String())
+	}
+	if !notice.IsValid() {
+		t.Error("Expected registered level to be valid")
+	}
+	if parsed, err := ParseLevel("Notice"); err != nil || parsed != notice {
+		t.Errorf("Expected ParseLevel to return NOTICE, got %v (%v)", parsed, err)
+	}
+	if notice.BuiltinLevel() != INFO {
+		t.Errorf("Expected NOTICE to map to INFO, got %s", notice.BuiltinLevel())
+	}
+	if !(INFO < notice && notice < WARN) {
+		t.Error("Expected NOTICE to be ordered between INFO and WARN")
+	}
+
+	// Registrar novamente com a mesma severidade é idempotente
+	if _, err := RegisterLevel("NOTICE", 2); err != nil {
+		t.Errorf("Expected idempotent registration, got: %v", err)
+	}
+
+	errorCases := []struct {
+		name     string
+		severity int
+	}{
+		{"", 3},
+		{"NOTICE", 3},  // nome já registrado com outra severidade
+		{"VERBOSE", 2}, // severidade já registrada
+		{"LOUD", int(WARN)},
+		{"warning", 5},
+	}
+	for _, tc := range errorCases {
+		if _, err := RegisterLevel(tc.name, tc.severity); err == nil {
+			t.Errorf("Expected error registering %q with severity %d", tc.name, tc.severity)
+		}
+	}
+}
+
+func TestLevel_BuiltinLevel(t *testing.T) {
+	tests := []struct {
+		level    Level
+		expected Level
+	}{
+		{Level(-100), TRACE},
+		{TRACE, TRACE},
+		{Level(-5), TRACE},
+		{DEBUG, DEBUG},
+		{Level(10), ERROR},
+		{PANIC, PANIC},
+		{Level(100), PANIC},
+	}
+
+	for _, tt := range tests {
+		if got := tt.level.BuiltinLevel(); got != tt.expected {
+			t.Errorf("Level(%d).BuiltinLevel() = %s, expected %s", int(tt.level), got, tt.expected)
+		}
+	}
+}
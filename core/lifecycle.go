@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+	"io"
+)
+
+// Flusher é implementado por adapters e writers que mantêm entradas em
+// buffer. Flush deve retornar após a escrita das entradas pendentes ou
+// quando ctx for cancelado.
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// FlushAdapter descarrega as entradas pendentes do adapter, se ele
+// implementar Flusher. Adapters sem buffer são ignorados.
+func FlushAdapter(ctx context.Context, adapter LoggerAdapter) error {
+	if flusher, ok := adapter.(Flusher); ok {
+		return flusher.Flush(ctx)
+	}
+	return nil
+}
+
+// CloseAdapter libera os recursos do adapter (arquivos, clientes de
+// métricas), se ele implementar io.Closer. Adapters sem recursos são
+// ignorados.
+func CloseAdapter(adapter LoggerAdapter) error {
+	if closer, ok := adapter.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// FlushWriter descarrega as entradas pendentes do writer, se ele
+// implementar Flusher
+func FlushWriter(ctx context.Context, w io.Writer) error {
+	if flusher, ok := w.(Flusher); ok {
+		return flusher.Flush(ctx)
+	}
+	return nil
+}
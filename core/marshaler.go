@@ -0,0 +1,141 @@
+package core
+
+import (
+	"time"
+)
+
+// ObjectMarshaler é implementado por tipos de domínio que descrevem
+// explicitamente sua representação em log, campo a campo. Diferente de Any,
+// que serializa a estrutura completa via reflection, apenas os campos
+// adicionados em MarshalLogObject são emitidos, e o resultado passa pela
+// mesma sanitização aplicada aos demais campos.
+//
+// Exemplo:
+//
+//	func (o Order) MarshalLogObject(e core.LogEvent) {
+//		e.Str("id", o.ID).
+//			Int("items", len(o.Items)).
+//			Float64("total", o.Total)
+//	}
+type ObjectMarshaler interface {
+	// MarshalLogObject adiciona os campos do objeto ao LogEvent recebido.
+	// Msg, Msgf e Send não têm efeito neste evento.
+	MarshalLogObject(e LogEvent)
+}
+
+// ArrayMarshaler é implementado por coleções que descrevem explicitamente
+// sua representação em log, elemento a elemento
+type ArrayMarshaler interface {
+	// MarshalLogArray adiciona os elementos da coleção ao LogArray recebido
+	MarshalLogArray(a LogArray)
+}
+
+// LogArray define a interface para construção de arrays em entradas de log
+type LogArray interface {
+	// Str adiciona um elemento string ao array
+	Str(val string) LogArray
+
+	// Int adiciona um elemento inteiro ao array
+	Int(val int) LogArray
+
+	// Int64 adiciona um elemento int64 ao array
+	Int64(val int64) LogArray
+
+	// Uint64 adiciona um elemento uint64 ao array
+	Uint64(val uint64) LogArray
+
+	// Float64 adiciona um elemento float64 ao array
+	Float64(val float64) LogArray
+
+	// Bool adiciona um elemento booleano ao array
+	Bool(val bool) LogArray
+
+	// Dur adiciona um elemento de duração ao array
+	Dur(val time.Duration) LogArray
+
+	// Time adiciona um elemento de data/hora ao array
+	Time(val time.Time) LogArray
+
+	// Object adiciona um objeto ao array
+	Object(obj ObjectMarshaler) LogArray
+}
+
+// Object cria um campo com a representação de um ObjectMarshaler.
+// Os campos do objeto são coletados imediatamente.
+func Object(key string, obj ObjectMarshaler) Field {
+	if obj == nil {
+		return Field{Key: key, Type: AnyType}
+	}
+	return Dict(key, collectFields(obj.MarshalLogObject)...)
+}
+
+// Array cria um campo com a representação de um ArrayMarshaler.
+// Os elementos do array são coletados imediatamente.
+func Array(key string, arr ArrayMarshaler) Field {
+	if arr == nil {
+		return Field{Key: key, Type: AnyType}
+	}
+	a := &logArray{}
+	arr.MarshalLogArray(a)
+	return Field{Key: key, Type: ArrayType, Interface: a.elements}
+}
+
+// logArray é a implementação concreta da interface LogArray.
+// Os elementos são armazenados como campos sem chave.
+type logArray struct {
+	elements []Field
+}
+
+// Str adiciona um elemento string ao array
+func (a *logArray) Str(val string) LogArray {
+	a.elements = append(a.elements, String("", val))
+	return a
+}
+
+// Int adiciona um elemento inteiro ao array
+func (a *logArray) Int(val int) LogArray {
+	a.elements = append(a.elements, Int("", val))
+	return a
+}
+
+// Int64 adiciona um elemento int64 ao array
+func (a *logArray) Int64(val int64) LogArray {
+	a.elements = append(a.elements, Int64("", val))
+	return a
+}
+
+// Uint64 adiciona um elemento uint64 ao array
+func (a *logArray) Uint64(val uint64) LogArray {
+	a.elements = append(a.elements, Uint64("", val))
+	return a
+}
+
+// Float64 adiciona um elemento float64 ao array
+func (a *logArray) Float64(val float64) LogArray {
+	a.elements = append(a.elements, Float64("", val))
+	return a
+}
+
+// Bool adiciona um elemento booleano ao array
+func (a *logArray) Bool(val bool) LogArray {
+	a.elements = append(a.elements, Bool("", val))
+	return a
+}
+
+// Dur adiciona um elemento de duração ao array
+func (a *logArray) Dur(val time.Duration) LogArray {
+	a.elements = append(a.elements, Duration("", val))
+	return a
+}
+
+// Time adiciona um elemento de data/hora ao array
+func (a *logArray) Time(val time.Time) LogArray {
+	a.elements = append(a.elements, Time("", val))
+	return a
+}
+
+// Object adiciona um objeto ao array
+func (a *logArray) Object(obj ObjectMarshaler) LogArray {
+	a.elements = append(a.elements, Object("", obj))
+	return a
+}
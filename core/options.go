@@ -0,0 +1,109 @@
+package core
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+)
+
+const (
+	// CallerKey é a chave do campo com o arquivo e a linha da chamada de log
+	CallerKey = "caller"
+	// FunctionKey é a chave do campo com a função que originou a chamada de log
+	FunctionKey = "function"
+)
+
+// callerDepth é o número de frames entre runtime.Caller e o código do usuário:
+// captureCaller <- finish <- Msg/Msgf/Send <- usuário
+const callerDepth = 3
+
+// EventOptions define o comportamento dos LogEvents criados por um logger.
+// Uma mesma instância é compartilhada entre os eventos e não deve ser
+// modificada após o uso.
+type EventOptions struct {
+	// CallerEnabled adiciona os campos "caller" (arquivo:linha) e "function"
+	// com a origem da chamada a Msg, Msgf ou Send
+	CallerEnabled bool
+	// CallerSkip define quantos frames adicionais devem ser ignorados ao
+	// identificar o caller, para uso em funções auxiliares de logging
+	CallerSkip int
+	// StackTrace define quando Err captura o stack trace no call site para
+	// erros que não carregam um stack trace próprio
+	StackTrace StackTracePolicy
+	// Sampler decide quais entradas são registradas (opcional). Entradas
+	// FATAL e PANIC nunca são amostradas.
+	Sampler Sampler
+	// RateLimit limita a taxa de entradas por call site de Msg, Msgf ou Send,
+	// em entradas por segundo (0 = sem limite). Pode ser sobrescrito por
+	// entrada via LogEvent.RateLimited.
+	RateLimit float64
+}
+
+// DefaultEventOptions retorna as opções padrão dos eventos de log
+func DefaultEventOptions() EventOptions {
+	return EventOptions{
+		CallerEnabled: false,
+		CallerSkip:    0,
+		StackTrace:    StackTraceOnError,
+	}
+}
+
+// WithCaller habilita ou desabilita a captura do caller
+func (o EventOptions) WithCaller(enabled bool) EventOptions {
+	o.CallerEnabled = enabled
+	return o
+}
+
+// WithCallerSkip configura quantos frames adicionais devem ser ignorados
+func (o EventOptions) WithCallerSkip(skip int) EventOptions {
+	o.CallerSkip = skip
+	return o
+}
+
+// WithStackTrace configura a política de captura de stack traces em Err
+func (o EventOptions) WithStackTrace(policy StackTracePolicy) EventOptions {
+	o.StackTrace = policy
+	return o
+}
+
+// WithSampler configura o sampler das entradas de log
+func (o EventOptions) WithSampler(sampler Sampler) EventOptions {
+	o.Sampler = sampler
+	return o
+}
+
+// WithRateLimit configura o limite padrão de entradas por segundo por call site
+func (o EventOptions) WithRateLimit(perSecond float64) EventOptions {
+	o.RateLimit = perSecond
+	return o
+}
+
+// NewLogEventWithOptions cria um novo LogEvent com as opções especificadas.
+// Se opts for nil, as opções padrão são utilizadas.
+func NewLogEventWithOptions(adapter LoggerAdapter, ctx context.Context, level Level, opts *EventOptions) LogEvent {
+	e := NewLogEvent(adapter, ctx, level).(*logEvent)
+	e.opts = opts
+	return e
+}
+
+// captureCaller adiciona os campos de caller ao evento. skip é relativo ao
+// código que chamou Msg, Msgf ou Send.
+func (e *logEvent) captureCaller(skip int) {
+	pc, file, line, ok := runtime.Caller(callerDepth + skip)
+	if !ok {
+		return
+	}
+
+	e.appendField(String(CallerKey, file+":"+strconv.Itoa(line)))
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		e.appendField(String(FunctionKey, fn.Name()))
+	}
+}
+
+// stackTracePolicy retorna a política de stack trace do evento
+func (e *logEvent) stackTracePolicy() StackTracePolicy {
+	if e.opts == nil {
+		return StackTraceOnError
+	}
+	return e.opts.StackTrace
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -39,6 +40,8 @@ type OutputConfig struct {
 	Compress bool
 	// LocalTime determina se deve usar horário local para timestamps nos nomes dos arquivos
 	LocalTime bool
+	// Async habilita a escrita assíncrona, desacoplando as chamadas de log do I/O
+	Async AsyncConfig
 }
 
 // OutputManager gerencia a saída de logs para diferentes destinos
@@ -50,6 +53,12 @@ type OutputManager struct {
 	mu            sync.RWMutex
 	lastRotation  time.Time
 	rotationCount int64
+	// unregisterShutdown remove o shutdown hook que fecha o arquivo
+	unregisterShutdown func()
+	// asyncWriter e asyncMultiWriter são os writers assíncronos retornados
+	// por GetWriter e GetMultiWriter quando Async está habilitado
+	asyncWriter      *AsyncWriter
+	asyncMultiWriter *AsyncWriter
 }
 
 // Constantes para valores padrão
@@ -97,6 +106,11 @@ func NewOutputManager(config OutputConfig) (*OutputManager, error) {
 		om.isFileMode = true
 	}
 
+	// Garantir que o arquivo seja fechado antes do encerramento via Exit
+	om.unregisterShutdown = OnShutdown("output", func(ctx context.Context) error {
+		return om.Close()
+	})
+
 	return om, nil
 }
 
@@ -133,6 +147,10 @@ func (om *OutputManager) validateConfig() error {
 		return fmt.Errorf("max backups cannot be negative, got %d", om.config.MaxBackups)
 	}
 
+	if err := om.config.Async.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -161,25 +179,93 @@ func (om *OutputManager) setupFileOutput() error {
 // GetWriter retorna o writer apropriado baseado na configuração
 func (om *OutputManager) GetWriter() io.Writer {
 	if om.isFileMode && om.fileWriter != nil {
-		return om.fileWriter
+		return om.wrapAsync(om.fileWriter, &om.asyncWriter)
 	}
 
 	// Fallback para stdout se não há configuração de arquivo
-	return os.Stdout
+	return om.wrapAsync(os.Stdout, &om.asyncWriter)
 }
 
 // GetMultiWriter retorna um MultiWriter que escreve tanto para stdout quanto para arquivo
 func (om *OutputManager) GetMultiWriter() io.Writer {
 	if om.isFileMode && om.fileWriter != nil {
-		return io.MultiWriter(os.Stdout, om.fileWriter)
+		return om.wrapAsync(io.MultiWriter(os.Stdout, om.fileWriter), &om.asyncMultiWriter)
 	}
 
 	// Se não há arquivo configurado, retorna apenas stdout
-	return os.Stdout
+	return om.wrapAsync(os.Stdout, &om.asyncMultiWriter)
 }
 
-// Close fecha o writer de arquivo se estiver aberto
+// wrapAsync envolve o writer em um AsyncWriter quando a escrita assíncrona
+// está habilitada, reutilizando o AsyncWriter já criado para o slot
+func (om *OutputManager) wrapAsync(w io.Writer, slot **AsyncWriter) io.Writer {
+	if !om.config.Async.Enabled {
+		return w
+	}
+
+	om.mu.Lock()
+	defer om.mu.Unlock()
+	if *slot == nil {
+		*slot = NewAsyncWriter(w, om.config.Async)
+	}
+	return *slot
+}
+
+// Flush implementa a interface Flusher, aguardando a escrita das entradas
+// enfileiradas até a chamada nos writers assíncronos
+func (om *OutputManager) Flush(ctx context.Context) error {
+	om.mu.RLock()
+	writers := []*AsyncWriter{om.asyncWriter, om.asyncMultiWriter}
+	om.mu.RUnlock()
+
+	for _, w := range writers {
+		if w == nil {
+			continue
+		}
+		if err := w.Flush(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AsyncStats retorna os contadores somados dos writers assíncronos. Os
+// contadores são zero quando a escrita assíncrona está desabilitada.
+func (om *OutputManager) AsyncStats() AsyncStats {
+	om.mu.RLock()
+	writers := []*AsyncWriter{om.asyncWriter, om.asyncMultiWriter}
+	om.mu.RUnlock()
+
+	var stats AsyncStats
+	for _, w := range writers {
+		if w == nil {
+			continue
+		}
+		s := w.Stats()
+		stats.Written += s.Written
+		stats.Dropped += s.Dropped
+		stats.Pending += s.Pending
+	}
+	return stats
+}
+
+// Close esvazia os writers assíncronos e fecha o writer de arquivo se estiver aberto
 func (om *OutputManager) Close() error {
+	if om.unregisterShutdown != nil {
+		om.unregisterShutdown()
+	}
+
+	om.mu.Lock()
+	writers := []*AsyncWriter{om.asyncWriter, om.asyncMultiWriter}
+	om.asyncWriter, om.asyncMultiWriter = nil, nil
+	om.mu.Unlock()
+
+	for _, w := range writers {
+		if w != nil {
+			_ = w.Close()
+		}
+	}
+
 	if om.fileWriter != nil {
 		return om.fileWriter.Close()
 	}
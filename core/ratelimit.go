@@ -0,0 +1,136 @@
+package core
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// SuppressedKey é a chave do campo com a quantidade de entradas suprimidas
+// pelo limite de taxa desde a última entrada registrada do mesmo call site
+const SuppressedKey = "suppressed"
+
+// maxRateLimitKeys limita o número de call sites e chaves rastreados.
+// Entradas de chaves excedentes não são limitadas.
+const maxRateLimitKeys = 10000
+
+// rateKey identifica um limite: pela chave explícita passada a RateLimited
+// ou pelo call site de Msg, Msgf ou Send
+type rateKey struct {
+	name string
+	pc   uintptr
+}
+
+// tokenBucket limita a taxa de entradas de um call site
+type tokenBucket struct {
+	mu         sync.Mutex
+	rate       float64
+	tokens     float64
+	last       time.Time
+	suppressed uint64
+}
+
+// allow consome um token se disponível. Retorna se a entrada deve ser
+// registrada e, nesse caso, quantas entradas foram suprimidas desde a
+// última registrada.
+func (b *tokenBucket) allow(rate float64, now time.Time) (bool, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// A capacidade do bucket permite rajadas de até um segundo de entradas
+	burst := math.Max(1, rate)
+	if b.rate != rate {
+		b.rate = rate
+		b.tokens = math.Min(b.tokens, burst)
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		b.suppressed++
+		return false, 0
+	}
+	b.tokens--
+
+	suppressed := b.suppressed
+	b.suppressed = 0
+	return true, suppressed
+}
+
+// rateLimiter mantém os token buckets por call site e chave
+type rateLimiter struct {
+	mu      sync.RWMutex
+	buckets map[rateKey]*tokenBucket
+}
+
+// callSiteLimiter é o limitador compartilhado por todos os eventos
+var callSiteLimiter = &rateLimiter{buckets: make(map[rateKey]*tokenBucket)}
+
+// allow aplica o limite de taxa rate (entradas por segundo) à chave
+func (l *rateLimiter) allow(key rateKey, rate float64) (bool, uint64) {
+	now := time.Now()
+
+	l.mu.RLock()
+	bucket, ok := l.buckets[key]
+	l.mu.RUnlock()
+
+	if !ok {
+		l.mu.Lock()
+		if bucket, ok = l.buckets[key]; !ok {
+			if len(l.buckets) >= maxRateLimitKeys {
+				l.mu.Unlock()
+				return true, 0
+			}
+			bucket = &tokenBucket{rate: rate, tokens: math.Max(1, rate), last: now}
+			l.buckets[key] = bucket
+		}
+		l.mu.Unlock()
+	}
+
+	return bucket.allow(rate, now)
+}
+
+// RateLimited limita a taxa de entradas do call site de Msg, Msgf ou Send,
+// ou da chave especificada quando não vazia
+func (e *logEvent) RateLimited(key string, perSecond float64) LogEvent {
+	e.rateKey = key
+	e.rateLimit = perSecond
+	e.rateLimitSet = true
+	return e
+}
+
+// allowRate aplica o limite de taxa configurado no evento ou nas opções do
+// logger. Quando a entrada é registrada após supressões, adiciona o campo
+// "suppressed" com a quantidade suprimida. Deve ser chamado por finish.
+func (e *logEvent) allowRate() bool {
+	rate, name := e.rateLimit, e.rateKey
+	if !e.rateLimitSet {
+		if e.opts == nil {
+			return true
+		}
+		rate, name = e.opts.RateLimit, ""
+	}
+	if rate <= 0 || e.level == FATAL || e.level == PANIC {
+		return true
+	}
+
+	key := rateKey{name: name}
+	if name == "" {
+		// runtime.Callers <- allowRate <- finish <- Msg/Msgf/Send <- usuário
+		var pcs [1]uintptr
+		skip := callerDepth + 1
+		if e.opts != nil {
+			skip += e.opts.CallerSkip
+		}
+		if runtime.Callers(skip, pcs[:]) > 0 {
+			key.pc = pcs[0]
+		}
+	}
+
+	allowed, suppressed := callSiteLimiter.allow(key, rate)
+	if allowed && suppressed > 0 {
+		e.appendField(Uint64(SuppressedKey, suppressed))
+	}
+	return allowed
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// resetRateLimiter descarta os limites acumulados por testes anteriores
+func resetRateLimiter() {
+	callSiteLimiter = &rateLimiter{buckets: make(map[rateKey]*tokenBucket)}
+}
+
+func TestLogEvent_RateLimited_CallSite(t *testing.T) {
+	resetRateLimiter()
+	adapter := newMockAdapter()
+	ctx := context.Background()
+	logAtSite := func(i int) {
+		NewLogEvent(adapter, ctx, ERROR).RateLimited("", 10).Int("i", i).Msg("connection refused")
+	}
+
+	for i := 0; i < 15; i++ {
+		logAtSite(i)
+	}
+	if len(adapter.logCalls) != 10 {
+		t.Fatalf("Expected burst of 10 entries, got %d", len(adapter.logCalls))
+	}
+
+	// Outro call site tem seu próprio limite
+	NewLogEvent(adapter, ctx, ERROR).RateLimited("", 10).Msg("other site")
+	if len(adapter.logCalls) != 11 {
+		t.Errorf("Expected independent limit per call site, got %d entries", len(adapter.logCalls))
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	logAtSite(15)
+
+	if len(adapter.logCalls) != 12 {
+		t.Fatalf("Expected entry after refill, got %d entries", len(adapter.logCalls))
+	}
+	last := adapter.logCalls[11].fields
+	if last["i"] != 15 || last[SuppressedKey] != uint64(5) {
+		t.Errorf("Expected suppressed count 5 on the next entry, got %v", last)
+	}
+}
+
+func TestLogEvent_RateLimited_KeyAndDefault(t *testing.T) {
+	resetRateLimiter()
+	adapter := newMockAdapter()
+	ctx := context.Background()
+	opts := DefaultEventOptions().WithRateLimit(1)
+
+	// A chave explícita é compartilhada entre call sites
+	NewLogEvent(adapter, ctx, WARN).RateLimited("payments", 1).Msg("first")
+	NewLogEvent(adapter, ctx, WARN).RateLimited("payments", 1).Msg("second")
+	if len(adapter.logCalls) != 1 {
+		t.Errorf("Expected shared key to limit both call sites, got %d entries", len(adapter.logCalls))
+	}
+
+	// O limite padrão das opções se aplica a cada call site
+	for i := 0; i < 3; i++ {
+		NewLogEventWithOptions(adapter, ctx, INFO, &opts).Msg("default limit")
+	}
+	if len(adapter.logCalls) != 2 {
+		t.Errorf("Expected default rate limit to keep 1 entry, got %d entries", len(adapter.logCalls))
+	}
+
+	// RateLimited com zero remove o limite padrão
+	for i := 0; i < 3; i++ {
+		NewLogEventWithOptions(adapter, ctx, INFO, &opts).RateLimited("", 0).Msg("unlimited")
+	}
+	if len(adapter.logCalls) != 5 {
+		t.Errorf("Expected RateLimited(0) to disable the limit, got %d entries", len(adapter.logCalls))
+	}
+
+	// Eventos desabilitados ignoram o limite
+	if DisabledEvent().RateLimited("", 1) != DisabledEvent() {
+		t.Error("Expected disabled event to ignore RateLimited")
+	}
+}
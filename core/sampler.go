@@ -0,0 +1,177 @@
+package core
+
+import (
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Sampler decide se uma entrada de log deve ser registrada. Entradas
+// descartadas pelo sampler não chegam a ser construídas. Entradas FATAL e
+// PANIC nunca são amostradas.
+type Sampler interface {
+	// Sample retorna true se a entrada do nível especificado deve ser registrada
+	Sample(level Level) bool
+}
+
+// SamplerFunc permite usar uma função comum como Sampler
+type SamplerFunc func(level Level) bool
+
+// Sample implementa a interface Sampler
+func (f SamplerFunc) Sample(level Level) bool {
+	return f(level)
+}
+
+// ShouldSample aplica o sampler ao nível especificado. Retorna true quando
+// sampler é nil ou o nível é FATAL ou PANIC.
+func ShouldSample(sampler Sampler, level Level) bool {
+	if sampler == nil || level == FATAL || level == PANIC {
+		return true
+	}
+	return sampler.Sample(level)
+}
+
+// dropCounter contabiliza as entradas descartadas por um sampler
+type dropCounter struct {
+	dropped atomic.Uint64
+}
+
+// record contabiliza o descarte quando keep é false e retorna keep
+func (c *dropCounter) record(keep bool) bool {
+	if !keep {
+		c.dropped.Add(1)
+	}
+	return keep
+}
+
+// Dropped retorna o número de entradas descartadas pelo sampler
+func (c *dropCounter) Dropped() uint64 {
+	return c.dropped.Load()
+}
+
+// EveryNSampler registra uma a cada N entradas
+type EveryNSampler struct {
+	dropCounter
+	n       uint64
+	counter atomic.Uint64
+}
+
+// NewEveryNSampler cria um sampler que registra a primeira de cada n
+// entradas. Valores de n menores ou iguais a 1 registram todas as entradas.
+func NewEveryNSampler(n int) *EveryNSampler {
+	if n < 1 {
+		n = 1
+	}
+	return &EveryNSampler{n: uint64(n)}
+}
+
+// Sample implementa a interface Sampler
+func (s *EveryNSampler) Sample(level Level) bool {
+	return s.record((s.counter.Add(1)-1)%s.n == 0)
+}
+
+// RandomSampler registra cada entrada com uma probabilidade fixa
+type RandomSampler struct {
+	dropCounter
+	rate float64
+}
+
+// NewRandomSampler cria um sampler que registra as entradas com a
+// probabilidade rate (0.0 a 1.0)
+func NewRandomSampler(rate float64) *RandomSampler {
+	return &RandomSampler{rate: rate}
+}
+
+// Sample implementa a interface Sampler
+func (s *RandomSampler) Sample(level Level) bool {
+	if s.rate >= 1 {
+		return true
+	}
+	return s.record(s.rate > 0 && rand.Float64() < s.rate)
+}
+
+// BurstSampler registra até Burst entradas por período e delega as
+// entradas excedentes ao sampler Next
+type BurstSampler struct {
+	dropCounter
+	burst  uint64
+	period time.Duration
+	next   Sampler
+
+	mu          sync.Mutex
+	periodStart time.Time
+	count       uint64
+}
+
+// NewBurstSampler cria um sampler que registra as primeiras burst entradas
+// de cada período. As entradas excedentes são decididas por next (ex:
+// NewEveryNSampler(100) para registrar 1 a cada 100) ou descartadas quando
+// next é nil.
+func NewBurstSampler(burst int, period time.Duration, next Sampler) *BurstSampler {
+	if burst < 0 {
+		burst = 0
+	}
+	return &BurstSampler{
+		burst:  uint64(burst),
+		period: period,
+		next:   next,
+	}
+}
+
+// Sample implementa a interface Sampler
+func (s *BurstSampler) Sample(level Level) bool {
+	if s.withinBurst() {
+		return true
+	}
+	return s.record(s.next != nil && s.next.Sample(level))
+}
+
+// withinBurst contabiliza a entrada no período corrente e informa se ela
+// está dentro do limite
+func (s *BurstSampler) withinBurst() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.period > 0 && now.Sub(s.periodStart) >= s.period {
+		s.periodStart = now
+		s.count = 0
+	}
+
+	if s.count < s.burst {
+		s.count++
+		return true
+	}
+	return false
+}
+
+// LevelSampler aplica um sampler diferente para cada nível. Níveis sem
+// sampler configurado são sempre registrados.
+type LevelSampler struct {
+	dropCounter
+	samplers map[Level]Sampler
+}
+
+// NewLevelSampler cria um sampler por nível. Por exemplo, para registrar
+// todas as entradas WARN ou superiores e 1% das entradas DEBUG:
+//
+//	core.NewLevelSampler(map[core.Level]core.Sampler{
+//		core.DEBUG: core.NewRandomSampler(0.01),
+//	})
+func NewLevelSampler(samplers map[Level]Sampler) *LevelSampler {
+	copied := make(map[Level]Sampler, len(samplers))
+	for level, sampler := range samplers {
+		copied[level] = sampler
+	}
+	return &LevelSampler{samplers: copied}
+}
+
+// Sample implementa a interface Sampler
+func (s *LevelSampler) Sample(level Level) bool {
+	sampler, ok := s.samplers[level]
+	if !ok || sampler == nil {
+		return true
+	}
+	return s.record(sampler.Sample(level))
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// sampleCount retorna quantas de n entradas do nível são aceitas pelo sampler
+func sampleCount(s Sampler, level Level, n int) int {
+	kept := 0
+	for i := 0; i < n; i++ {
+		if s.Sample(level) {
+			kept++
+		}
+	}
+	return kept
+}
+
+func TestEveryNSampler(t *testing.T) {
+	sampler := NewEveryNSampler(10)
+
+	if !sampler.Sample(INFO) {
+		t.Error("Expected the first entry to be sampled")
+	}
+	if kept := sampleCount(sampler, INFO, 99); kept != 9 {
+		t.Errorf("Expected 9 of the next 99 entries to be sampled, got %d", kept)
+	}
+	if sampler.Dropped() != 90 {
+		t.Errorf("Expected 90 dropped entries, got %d", sampler.Dropped())
+	}
+
+	if kept := sampleCount(NewEveryNSampler(0), INFO, 5); kept != 5 {
+		t.Errorf("Expected n <= 1 to keep every entry, got %d", kept)
+	}
+}
+
+func TestRandomSampler(t *testing.T) {
+	if kept := sampleCount(NewRandomSampler(1), INFO, 100); kept != 100 {
+		t.Errorf("Expected rate 1 to keep every entry, got %d", kept)
+	}
+
+	none := NewRandomSampler(0)
+	if kept := sampleCount(none, INFO, 100); kept != 0 {
+		t.Errorf("Expected rate 0 to drop every entry, got %d", kept)
+	}
+	if none.Dropped() != 100 {
+		t.Errorf("Expected 100 dropped entries, got %d", none.Dropped())
+	}
+
+	kept := sampleCount(NewRandomSampler(0.5), INFO, 10000)
+	if kept < 4000 || kept > 6000 {
+		t.Errorf("Expected about half of the entries to be sampled, got %d", kept)
+	}
+}
+
+func TestBurstSampler(t *testing.T) {
+	sampler := NewBurstSampler(3, time.Hour, NewEveryNSampler(5))
+
+	if kept := sampleCount(sampler, INFO, 3); kept != 3 {
+		t.Errorf("Expected the burst to be sampled, got %d", kept)
+	}
+	if kept := sampleCount(sampler, INFO, 10); kept != 2 {
+		t.Errorf("Expected 1 in 5 entries after the burst, got %d", kept)
+	}
+	if sampler.Dropped() != 8 {
+		t.Errorf("Expected 8 dropped entries, got %d", sampler.Dropped())
+	}
+
+	// Sem next, as entradas excedentes são descartadas até o próximo período
+	sampler = NewBurstSampler(2, 20*time.Millisecond, nil)
+	if kept := sampleCount(sampler, INFO, 5); kept != 2 {
+		t.Errorf("Expected only the burst to be sampled, got %d", kept)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if kept := sampleCount(sampler, INFO, 5); kept != 2 {
+		t.Errorf("Expected the burst to reset in the next period, got %d", kept)
+	}
+}
+
+func TestLevelSampler(t *testing.T) {
+	sampler := NewLevelSampler(map[Level]Sampler{
+		DEBUG: NewRandomSampler(0),
+		INFO:  NewEveryNSampler(2),
+	})
+
+	if kept := sampleCount(sampler, DEBUG, 10); kept != 0 {
+		t.Errorf("Expected DEBUG entries to be dropped, got %d", kept)
+	}
+	if kept := sampleCount(sampler, INFO, 10); kept != 5 {
+		t.Errorf("Expected half of the INFO entries, got %d", kept)
+	}
+	if kept := sampleCount(sampler, WARN, 10); kept != 10 {
+		t.Errorf("Expected every WARN entry, got %d", kept)
+	}
+	if sampler.Dropped() != 15 {
+		t.Errorf("Expected 15 dropped entries, got %d", sampler.Dropped())
+	}
+}
+
+func TestShouldSample(t *testing.T) {
+	dropAll := SamplerFunc(func(level Level) bool { return false })
+
+	if !ShouldSample(nil, INFO) {
+		t.Error("Expected nil sampler to keep entries")
+	}
+	if ShouldSample(dropAll, ERROR) {
+		t.Error("Expected ERROR to be sampled by the sampler")
+	}
+	if !ShouldSample(dropAll, FATAL) || !ShouldSample(dropAll, PANIC) {
+		t.Error("Expected FATAL and PANIC to never be sampled")
+	}
+}
@@ -10,7 +10,7 @@
 //
 //   - Interface fluente com method chaining
 //   - Suporte a logging estruturado com campos tipados
-//   - Níveis de log padrão (DEBUG, INFO, WARN, ERROR, FATAL)
+//   - Níveis de log padrão (TRACE, DEBUG, INFO, WARN, ERROR, FATAL, PANIC) e customizados
 //   - Propagação de contexto
 //   - Campos pré-definidos por instância
 //   - Logger global thread-safe
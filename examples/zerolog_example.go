@@ -86,8 +86,8 @@ func zerologExample() {
 	serviceLogger.Error(ctx).Msg("Error that doesn't stop execution")
 
 	// Exemplo 5: Log com contexto
-	userCtx := context.WithValue(ctx, "user_id", "user-789")
-	userCtx = context.WithValue(userCtx, "session_id", "session-abc123")
+	userCtx := core.WithUserID(ctx, "user-789")
+	userCtx = core.WithSessionID(userCtx, "session-abc123")
 
 	contextLogger := serviceLogger.WithContext(userCtx)
 	contextLogger.Info(ctx).
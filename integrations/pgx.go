@@ -64,6 +64,11 @@ func (pl *PgxLogger) Log(ctx context.Context, level tracelog.LogLevel, msg strin
 	// Mapear nível do PGX para nível do nosso logger
 	logLevel := pl.mapLogLevel(level)
 
+	// Respeitar o override de nível do componente (ex: LOGGER_LEVELS="pgx=warn")
+	if !core.IsComponentLevelEnabled(pl.config.Logger, "pgx", logLevel) {
+		return
+	}
+
 	// Preparar campos do log
 	fields := make(map[string]interface{})
 	fields["component"] = "pgx"
@@ -115,7 +120,7 @@ func (pl *PgxLogger) Log(ctx context.Context, level tracelog.LogLevel, msg strin
 func (pl *PgxLogger) mapLogLevel(level tracelog.LogLevel) core.Level {
 	switch level {
 	case tracelog.LogLevelTrace:
-		return core.DEBUG
+		return core.TRACE
 	case tracelog.LogLevelDebug:
 		return core.DEBUG
 	case tracelog.LogLevelInfo:
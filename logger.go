@@ -17,6 +17,10 @@ import (
 //		Int("attempt", 1).
 //		Msg("User login successful")
 type Logger interface {
+	// Trace cria uma entrada de log de nível TRACE.
+	// Usado para rastreamento muito detalhado, mais verboso que DEBUG.
+	Trace(ctx context.Context) core.LogEvent
+
 	// Debug cria uma entrada de log de nível DEBUG.
 	// Usado para informações detalhadas de depuração que normalmente
 	// só são de interesse ao diagnosticar problemas.
@@ -38,10 +42,19 @@ type Logger interface {
 	Error(ctx context.Context) core.LogEvent
 
 	// Fatal cria uma entrada de log de nível FATAL.
-	// Usado para erros muito severos que provavelmente
-	// levarão à terminação da aplicação.
+	// Usado para erros muito severos que impedem a continuidade da aplicação.
+	// Após o envio da entrada executa os shutdown hooks e encerra o processo
+	// via core.Exit.
 	Fatal(ctx context.Context) core.LogEvent
 
+	// Panic cria uma entrada de log de nível PANIC.
+	// Após o envio da entrada (Msg, Msgf ou Send) dispara um panic com a mensagem.
+	Panic(ctx context.Context) core.LogEvent
+
+	// Log cria uma entrada de log com o nível especificado, incluindo
+	// níveis customizados registrados via core.RegisterLevel.
+	Log(ctx context.Context, level core.Level) core.LogEvent
+
 	// WithContext retorna uma nova instância do logger com o contexto especificado.
 	// Útil para propagar informações de contexto através de chamadas de log.
 	WithContext(ctx context.Context) Logger
@@ -49,6 +62,34 @@ type Logger interface {
 	// WithFields retorna uma nova instância do logger com campos pré-definidos.
 	// Útil para adicionar campos comuns que serão incluídos em todas as entradas de log.
 	WithFields(fields map[string]interface{}) Logger
+
+	// Named retorna uma nova instância do logger identificada pelo componente
+	// especificado, adicionando o campo "component" a todas as entradas.
+	// O nível mínimo do componente pode ser sobrescrito via LOGGER_LEVELS,
+	// Config.ComponentLevels ou Levels().SetComponentLevel. Chamadas
+	// sucessivas substituem o nome do componente.
+	Named(name string) Logger
+
+	// WithHooks retorna uma nova instância do logger que executa os hooks
+	// especificados, em ordem, sobre cada entrada antes da escrita. Os hooks
+	// são executados após os hooks já configurados no logger e, quando o
+	// adapter implementa core.HookableAdapter, após a formatação e o
+	// enriquecimento de observabilidade.
+	WithHooks(hooks ...core.Hook) Logger
+
+	// Sample retorna uma nova instância do logger que registra apenas as
+	// entradas aceitas pelo sampler, substituindo o sampler anterior. Entradas
+	// descartadas não são construídas e FATAL e PANIC nunca são amostradas.
+	Sample(sampler core.Sampler) Logger
+
+	// Flush descarrega as entradas mantidas em buffer pelo adapter,
+	// respeitando o prazo de ctx.
+	Flush(ctx context.Context) error
+
+	// Close libera os recursos do adapter, como arquivos de log e clientes
+	// do Datadog. O adapter é compartilhado pelos loggers derivados via
+	// WithFields, WithContext e Named, que também deixam de escrever.
+	Close() error
 }
 
 // logger é a implementação concreta da interface Logger
@@ -56,6 +97,14 @@ type logger struct {
 	adapter LoggerAdapter
 	ctx     context.Context
 	fields  map[string]interface{}
+	// preset contém os campos pré-definidos já convertidos para campos tipados,
+	// evitando a conversão a cada entrada de log. Nunca é modificado após a criação.
+	preset []core.Field
+	// opts contém as opções compartilhadas pelos eventos criados pelo logger
+	opts *core.EventOptions
+	// component é o valor do campo "component" pré-definido (via Named ou
+	// WithFields), usado para resolver o nível mínimo antes de criar o evento
+	component string
 }
 
 // LoggerAdapter é um alias para core.LoggerAdapter para facilitar o uso
@@ -69,49 +118,73 @@ type LoggerAdapter = core.LoggerAdapter
 //	adapter := &MyLoggerAdapter{}
 //	log := logger.New(adapter)
 func New(adapter LoggerAdapter) Logger {
+	return NewWithOptions(adapter, core.DefaultEventOptions())
+}
+
+// NewWithOptions cria uma nova instância de Logger com opções de eventos
+// personalizadas, como a captura do caller.
+//
+// Exemplo:
+//
+//	log := logger.NewWithOptions(adapter, core.DefaultEventOptions().WithCaller(true))
+func NewWithOptions(adapter LoggerAdapter, opts core.EventOptions) Logger {
 	return &logger{
 		adapter: adapter,
 		ctx:     context.Background(),
 		fields:  make(map[string]interface{}),
+		opts:    &opts,
 	}
 }
 
+// Trace cria uma entrada de log de nível TRACE
+func (l *logger) Trace(ctx context.Context) core.LogEvent {
+	return l.newEvent(ctx, core.TRACE)
+}
+
 // Debug cria uma entrada de log de nível DEBUG
 func (l *logger) Debug(ctx context.Context) core.LogEvent {
-	event := core.NewLogEvent(l.adapter, ctx, core.DEBUG)
-	return l.addPresetFields(event)
+	return l.newEvent(ctx, core.DEBUG)
 }
 
 // Info cria uma entrada de log de nível INFO
 func (l *logger) Info(ctx context.Context) core.LogEvent {
-	event := core.NewLogEvent(l.adapter, ctx, core.INFO)
-	return l.addPresetFields(event)
+	return l.newEvent(ctx, core.INFO)
 }
 
 // Warn cria uma entrada de log de nível WARN
 func (l *logger) Warn(ctx context.Context) core.LogEvent {
-	event := core.NewLogEvent(l.adapter, ctx, core.WARN)
-	return l.addPresetFields(event)
+	return l.newEvent(ctx, core.WARN)
 }
 
 // Error cria uma entrada de log de nível ERROR
 func (l *logger) Error(ctx context.Context) core.LogEvent {
-	event := core.NewLogEvent(l.adapter, ctx, core.ERROR)
-	return l.addPresetFields(event)
+	return l.newEvent(ctx, core.ERROR)
 }
 
 // Fatal cria uma entrada de log de nível FATAL
 func (l *logger) Fatal(ctx context.Context) core.LogEvent {
-	event := core.NewLogEvent(l.adapter, ctx, core.FATAL)
-	return l.addPresetFields(event)
+	return l.newEvent(ctx, core.FATAL)
+}
+
+// Panic cria uma entrada de log de nível PANIC
+func (l *logger) Panic(ctx context.Context) core.LogEvent {
+	return l.newEvent(ctx, core.PANIC)
+}
+
+// Log cria uma entrada de log com o nível especificado
+func (l *logger) Log(ctx context.Context, level core.Level) core.LogEvent {
+	return l.newEvent(ctx, level)
 }
 
 // WithContext retorna uma nova instância do logger com o contexto especificado
 func (l *logger) WithContext(ctx context.Context) Logger {
 	return &logger{
-		adapter: l.adapter.WithContext(ctx),
-		ctx:     ctx,
-		fields:  l.copyFields(),
+		adapter:   l.adapter.WithContext(ctx),
+		ctx:       ctx,
+		fields:    l.copyFields(),
+		preset:    l.preset,
+		opts:      l.opts,
+		component: l.component,
 	}
 }
 
@@ -122,17 +195,79 @@ func (l *logger) WithFields(fields map[string]interface{}) Logger {
 		newFields[k] = v
 	}
 
+	component, _ := newFields[core.ComponentKey].(string)
+
+	return &logger{
+		adapter:   l.adapter,
+		ctx:       l.ctx,
+		fields:    newFields,
+		preset:    core.FieldsFromMap(newFields),
+		opts:      l.opts,
+		component: component,
+	}
+}
+
+// Named retorna uma nova instância do logger identificada pelo componente
+func (l *logger) Named(name string) Logger {
+	return l.WithFields(map[string]interface{}{core.ComponentKey: name})
+}
+
+// WithHooks retorna uma nova instância do logger que executa os hooks especificados
+func (l *logger) WithHooks(hooks ...core.Hook) Logger {
+	return &logger{
+		adapter:   core.WithAdapterHooks(l.adapter, hooks...),
+		ctx:       l.ctx,
+		fields:    l.copyFields(),
+		preset:    l.preset,
+		opts:      l.opts,
+		component: l.component,
+	}
+}
+
+// Sample retorna uma nova instância do logger com o sampler especificado
+func (l *logger) Sample(sampler core.Sampler) Logger {
+	opts := l.opts.WithSampler(sampler)
 	return &logger{
-		adapter: l.adapter,
-		ctx:     l.ctx,
-		fields:  newFields,
+		adapter:   l.adapter,
+		ctx:       l.ctx,
+		fields:    l.copyFields(),
+		preset:    l.preset,
+		opts:      &opts,
+		component: l.component,
 	}
 }
 
+// Flush descarrega as entradas mantidas em buffer pelo adapter
+func (l *logger) Flush(ctx context.Context) error {
+	return core.FlushAdapter(ctx, l.adapter)
+}
+
+// Close libera os recursos do adapter
+func (l *logger) Close() error {
+	return core.CloseAdapter(l.adapter)
+}
+
+// newEvent cria um LogEvent para o nível especificado. Quando o nível está
+// desabilitado ou a entrada é descartada pelo sampler retorna o evento
+// compartilhado que ignora todas as chamadas, sem alocar o evento nem copiar
+// os campos pré-definidos. Eventos PANIC e FATAL são sempre criados para que
+// o panic ou o encerramento ocorram independentemente do nível.
+func (l *logger) newEvent(ctx context.Context, level core.Level) core.LogEvent {
+	if level != core.PANIC && level != core.FATAL && !core.IsComponentLevelEnabled(l.adapter, l.component, level) {
+		return core.DisabledEvent()
+	}
+	if !core.ShouldSample(l.opts.Sampler, level) {
+		return core.DisabledEvent()
+	}
+
+	event := core.NewLogEventWithOptions(l.adapter, ctx, level, l.opts)
+	return l.addPresetFields(event)
+}
+
 // addPresetFields adiciona os campos pré-definidos ao evento de log
 func (l *logger) addPresetFields(event core.LogEvent) core.LogEvent {
-	if len(l.fields) > 0 {
-		event = event.Fields(l.fields)
+	if len(l.preset) > 0 {
+		event = event.TypedFields(l.preset...)
 	}
 	return event
 }
@@ -2,8 +2,11 @@ package logger
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/victorximenis/logger/adapters"
 	"github.com/victorximenis/logger/core"
 )
 
@@ -251,6 +254,11 @@ func TestLogger_AllLevels(t *testing.T) {
 	logger := New(adapter)
 	ctx := context.Background()
 
+	// Interceptar o encerramento do processo disparado pela entrada FATAL
+	var exitCodes []int
+	restore := core.SetExitFunc(func(code int) { exitCodes = append(exitCodes, code) })
+	defer restore()
+
 	// Testar todos os níveis de log
 	logger.Debug(ctx).Msg("debug message")
 	logger.Info(ctx).Msg("info message")
@@ -262,6 +270,10 @@ func TestLogger_AllLevels(t *testing.T) {
 		t.Fatalf("Expected 5 log calls, got %d", len(adapter.logCalls))
 	}
 
+	if len(exitCodes) != 1 || exitCodes[0] != 1 {
+		t.Errorf("Expected FATAL to exit once with code 1, got %v", exitCodes)
+	}
+
 	expectedLevels := []core.Level{core.DEBUG, core.INFO, core.WARN, core.ERROR, core.FATAL}
 	expectedMessages := []string{"debug message", "info message", "warn message", "error message", "fatal message"}
 
@@ -320,3 +332,212 @@ func (m *mockAdapter) IsLevelEnabled(level core.Level) bool {
 	}
 	return enabled
 }
+
+func TestLogger_DisabledLevelReturnsDisabledEvent(t *testing.T) {
+	adapter := &mockAdapter{levelEnabled: map[core.Level]bool{core.DEBUG: false}}
+	logger := New(adapter).WithFields(map[string]interface{}{"service": "auth"})
+	ctx := context.Background()
+
+	event := logger.Debug(ctx)
+
+	if event != core.DisabledEvent() {
+		t.Error("Debug should return the shared disabled event when DEBUG is disabled")
+	}
+	if event.Enabled() {
+		t.Error("Disabled event should report Enabled() == false")
+	}
+
+	event.Str("key", "value").Msg("debug message")
+
+	if len(adapter.logCalls) != 0 {
+		t.Errorf("Expected 0 log calls when level is disabled, got %d", len(adapter.logCalls))
+	}
+
+	if !logger.Info(ctx).Enabled() {
+		t.Error("Info event should be enabled")
+	}
+}
+
+func TestLogger_Named(t *testing.T) {
+	adapter := &mockAdapter{}
+	log := New(adapter).WithFields(map[string]interface{}{"service": "auth"})
+
+	ctx := context.Background()
+	log.Named("pgx").Named("pgx_pool").Info(ctx).Msg("named")
+
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+	fields := adapter.logCalls[0].fields
+	if fields["component"] != "pgx_pool" {
+		t.Errorf("Expected component 'pgx_pool', got %v", fields["component"])
+	}
+	if fields["service"] != "auth" {
+		t.Errorf("Expected preset field service='auth', got %v", fields["service"])
+	}
+}
+
+func TestLogger_PanicAndTrace(t *testing.T) {
+	adapter := &mockAdapter{levelEnabled: map[core.Level]bool{core.PANIC: false}}
+	log := New(adapter)
+	ctx := context.Background()
+
+	log.Trace(ctx).Msg("trace")
+	if len(adapter.logCalls) != 1 || adapter.logCalls[0].level != core.TRACE {
+		t.Fatalf("Expected TRACE entry, got %v", adapter.logCalls)
+	}
+
+	defer func() {
+		if r := recover(); r != "fatal state" {
+			t.Errorf("Expected panic with 'fatal state', got %v", r)
+		}
+	}()
+
+	// O panic ocorre mesmo com o nível PANIC desabilitado no adapter
+	log.Panic(ctx).Msgf("fatal %s", "state")
+	t.Error("Expected Panic to panic")
+}
+
+func TestLogger_CallerEnabled(t *testing.T) {
+	adapter := &mockAdapter{}
+	log := NewWithOptions(adapter, core.DefaultEventOptions().WithCaller(true)).
+		WithFields(map[string]interface{}{"service": "auth"}).
+		Named("auth")
+
+	log.Info(context.Background()).Msg("with caller")
+
+	calls := adapter.logCalls
+	if len(calls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(calls))
+	}
+
+	caller, _ := calls[0].fields[core.CallerKey].(string)
+	if !strings.Contains(caller, "logger_test.go:") {
+		t.Errorf("Expected caller in logger_test.go, got %q", caller)
+	}
+}
+
+// closingAdapter registra as chamadas de Flush e Close
+type closingAdapter struct {
+	mockAdapter
+	flushes int
+	closes  int
+}
+
+func (c *closingAdapter) Flush(ctx context.Context) error {
+	c.flushes++
+	return nil
+}
+
+func (c *closingAdapter) Close() error {
+	c.closes++
+	return errors.New("close failed")
+}
+
+func TestLogger_FlushAndClose(t *testing.T) {
+	adapter := &closingAdapter{}
+	log := New(adapter).Named("worker")
+
+	if err := log.Flush(context.Background()); err != nil {
+		t.Errorf("Expected no flush error, got %v", err)
+	}
+	if err := log.Close(); err == nil || err.Error() != "close failed" {
+		t.Errorf("Expected close error to be reported, got %v", err)
+	}
+	if adapter.flushes != 1 || adapter.closes != 1 {
+		t.Errorf("Expected 1 flush and 1 close, got %d and %d", adapter.flushes, adapter.closes)
+	}
+
+	// Adapters sem recursos são ignorados
+	plain := New(&mockAdapter{})
+	if err := plain.Flush(context.Background()); err != nil {
+		t.Errorf("Expected no error for plain adapter flush, got %v", err)
+	}
+	if err := plain.Close(); err != nil {
+		t.Errorf("Expected no error for plain adapter close, got %v", err)
+	}
+}
+
+func TestLogger_WithHooks(t *testing.T) {
+	adapter := &mockAdapter{}
+	tag := func(value string) core.Hook {
+		return core.HookFunc(func(entry *core.Entry) bool {
+			previous, _ := entry.Fields["hooks"].(string)
+			entry.Fields["hooks"] = previous + value
+			return entry.Message != "drop"
+		})
+	}
+
+	log := New(adapter).Named("billing").WithHooks(tag("a")).WithHooks(tag("b"))
+	ctx := context.Background()
+
+	log.Info(ctx).Msg("charged")
+	log.Info(ctx).Msg("drop")
+
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+	fields := adapter.logCalls[0].fields
+	if fields["hooks"] != "ab" {
+		t.Errorf("Expected hooks to run in order, got %v", fields["hooks"])
+	}
+	if fields["component"] != "billing" {
+		t.Errorf("Expected preset fields to reach hooks, got %v", fields)
+	}
+}
+
+func TestLogger_Sample(t *testing.T) {
+	adapter := &mockAdapter{}
+	sampler := core.NewLevelSampler(map[core.Level]core.Sampler{
+		core.INFO: core.NewEveryNSampler(4),
+	})
+	log := New(adapter).Named("ingest").Sample(sampler)
+	ctx := context.Background()
+
+	for i := 0; i < 8; i++ {
+		event := log.Info(ctx)
+		if i%4 != 0 && event != core.DisabledEvent() {
+			t.Errorf("Expected entry %d to be dropped before being built", i)
+		}
+		event.Int("i", i).Msg("ingested")
+	}
+	log.Warn(ctx).Msg("slow batch")
+
+	if len(adapter.logCalls) != 3 {
+		t.Fatalf("Expected 2 sampled INFO entries and 1 WARN, got %d", len(adapter.logCalls))
+	}
+	if adapter.logCalls[1].fields["i"] != 4 || adapter.logCalls[1].fields["component"] != "ingest" {
+		t.Errorf("Expected sampled entry to keep its fields, got %v", adapter.logCalls[1].fields)
+	}
+	if sampler.Dropped() != 6 {
+		t.Errorf("Expected 6 dropped entries, got %d", sampler.Dropped())
+	}
+}
+
+func TestLogger_LazyPreset(t *testing.T) {
+	var buf strings.Builder
+	adapter := adapters.NewZerologAdapter(&adapters.ZerologConfig{Writer: &buf, Level: core.INFO})
+	calls := 0
+	log := New(adapter).
+		WithFields(map[string]interface{}{
+			"snapshot": core.Lazy(func() interface{} {
+				calls++
+				return "state"
+			}),
+		}).
+		WithHooks(core.HookFunc(func(entry *core.Entry) bool {
+			return entry.Message != "vetoed"
+		}))
+	ctx := context.Background()
+
+	log.Debug(ctx).Msg("disabled")
+	log.Info(ctx).Msg("vetoed")
+	if calls != 0 {
+		t.Errorf("Expected preset not to be resolved for dropped entries, got %d calls", calls)
+	}
+
+	log.Info(ctx).Msg("written")
+	if calls != 1 || !strings.Contains(buf.String(), `"snapshot":"state"`) {
+		t.Errorf("Expected preset to be resolved once for the written entry, got %d calls: %s", calls, buf.String())
+	}
+}
@@ -38,6 +38,9 @@ func ChiMiddleware(config MiddlewareConfig) func(http.Handler) http.Handler {
 
 			// Criar contexto com request ID
 			ctx := core.WithCorrelationID(r.Context(), requestID)
+			ctx = core.WithRequestID(ctx, requestID)
+			// O padrão da rota só é resolvido após o middleware; usar o path
+			ctx = withRequestLogger(ctx, config, requestID, method, path)
 			r = r.WithContext(ctx)
 
 			// Log da requisição
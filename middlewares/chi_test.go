@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/victorximenis/logger"
+)
+
+func TestChiMiddleware(t *testing.T) {
+	adapter := &recordingAdapter{}
+
+	handler := ChiMiddleware(DefaultMiddlewareConfig(adapter))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger.FromContext(ctx).Info(ctx).Msg("handled")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	req := httptest.NewRequest(http.MethodDelete, "/orders/42", nil)
+	req.Header.Set("X-Request-ID", "req-chi")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status to be forwarded, got %d", rec.Code)
+	}
+	assertRequestLogging(t, adapter, "req-chi", http.MethodDelete, "/orders/42", http.StatusNotFound)
+}
@@ -30,6 +30,9 @@ func FiberMiddleware(config MiddlewareConfig) fiber.Handler {
 
 		// Criar contexto com request ID
 		ctx := core.WithCorrelationID(c.Context(), requestID)
+		ctx = core.WithRequestID(ctx, requestID)
+		// O padrão da rota só é resolvido após o middleware; usar o path
+		ctx = withRequestLogger(ctx, config, requestID, method, path)
 		c.SetUserContext(ctx)
 
 		// Log da requisição
@@ -0,0 +1,35 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/victorximenis/logger"
+)
+
+func TestFiberMiddleware(t *testing.T) {
+	adapter := &recordingAdapter{}
+
+	app := fiber.New()
+	app.Use(FiberMiddleware(DefaultMiddlewareConfig(adapter)))
+	app.Post("/orders", func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		logger.FromContext(ctx).Info(ctx).Msg("handled")
+		return c.SendStatus(http.StatusAccepted)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/orders", nil)
+	req.Header.Set("X-Request-ID", "req-fiber")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.Header.Get("X-Request-ID") != "req-fiber" {
+		t.Errorf("Expected X-Request-ID response header, got %q", resp.Header.Get("X-Request-ID"))
+	}
+	assertRequestLogging(t, adapter, "req-fiber", http.MethodPost, "/orders", http.StatusAccepted)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/victorximenis/logger"
 	"github.com/victorximenis/logger/core"
 )
 
@@ -29,6 +30,11 @@ type MiddlewareConfig struct {
 	SamplingRate float64
 	// Logger define o logger a ser usado
 	Logger core.LoggerAdapter
+	// RequestLogger define o logger base do logger da requisição, disponível
+	// nos handlers via logger.FromContext (opcional). Se nil, é usado o logger
+	// do contexto (ou o global) quando Logger for o adapter global, ou um
+	// logger criado a partir de Logger nos demais casos.
+	RequestLogger logger.Logger
 	// SkipPaths define paths que devem ser ignorados pelo middleware
 	SkipPaths []string
 	// SensitiveHeaderPatterns define padrões regex para headers sensíveis
@@ -116,6 +122,12 @@ func (c MiddlewareConfig) WithSamplingRate(rate float64) MiddlewareConfig {
 	return c
 }
 
+// WithRequestLogger configura o logger base do logger da requisição
+func (c MiddlewareConfig) WithRequestLogger(l logger.Logger) MiddlewareConfig {
+	c.RequestLogger = l
+	return c
+}
+
 // WithSkipPaths configura paths que devem ser ignorados
 func (c MiddlewareConfig) WithSkipPaths(paths ...string) MiddlewareConfig {
 	c.SkipPaths = paths
@@ -146,6 +158,8 @@ func GinMiddleware(config MiddlewareConfig) gin.HandlerFunc {
 
 		// Criar contexto com request ID
 		ctx := core.WithCorrelationID(c.Request.Context(), requestID)
+		ctx = core.WithRequestID(ctx, requestID)
+		ctx = withRequestLogger(ctx, config, requestID, method, ginRoute(c))
 		c.Request = c.Request.WithContext(ctx)
 
 		// Log da requisição
@@ -186,6 +200,15 @@ func extractOrGenerateRequestID(c *gin.Context) string {
 	return requestID
 }
 
+// ginRoute retorna o padrão da rota correspondente à requisição ou, para
+// requisições sem rota, o path
+func ginRoute(c *gin.Context) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return c.Request.URL.Path
+}
+
 // logRequest faz o log da requisição HTTP
 func logRequest(ctx context.Context, config MiddlewareConfig, c *gin.Context, requestID, method, path string) {
 	fields := map[string]interface{}{
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/victorximenis/logger"
+)
+
+func TestGinMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	adapter := &recordingAdapter{}
+
+	router := gin.New()
+	router.Use(GinMiddleware(DefaultMiddlewareConfig(adapter)))
+	router.GET("/orders/:id", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		logger.FromContext(ctx).Info(ctx).Msg("handled")
+		c.Status(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/orders/42", nil)
+	req.Header.Set("X-Request-ID", "req-gin")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Header().Get("X-Request-ID") != "req-gin" {
+		t.Errorf("Expected X-Request-ID response header, got %q", rec.Header().Get("X-Request-ID"))
+	}
+	assertRequestLogging(t, adapter, "req-gin", http.MethodGet, "/orders/:id", http.StatusCreated)
+}
+
+func TestGinMiddleware_SkipPaths(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	adapter := &recordingAdapter{}
+
+	router := gin.New()
+	router.Use(GinMiddleware(DefaultMiddlewareConfig(adapter)))
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if len(adapter.calls) != 0 {
+		t.Errorf("Expected skipped path not to be logged, got %d calls", len(adapter.calls))
+	}
+}
@@ -1,11 +1,13 @@
 package middlewares
 
 import (
+	"context"
 	"regexp"
 	"strings"
 	"sync"
 
 	"github.com/google/uuid"
+	"github.com/victorximenis/logger"
 	"github.com/victorximenis/logger/sanitize"
 )
 
@@ -163,6 +165,31 @@ func extractRequestIDFromHeaders(getHeader func(string) string) string {
 	return ""
 }
 
+// withRequestLogger armazena no contexto o logger da requisição com
+// request_id, method e route pré-definidos, recuperável nos handlers via
+// logger.FromContext. O logger é derivado de config.RequestLogger quando
+// definido; do logger do contexto (ou do global) quando config.Logger for o
+// adapter global, preservando seus campos pré-definidos e opções; ou de um
+// logger criado a partir de config.Logger.
+func withRequestLogger(ctx context.Context, config MiddlewareConfig, requestID, method, route string) context.Context {
+	var base logger.Logger
+	switch {
+	case config.RequestLogger != nil:
+		base = config.RequestLogger
+	case config.Logger == nil || config.Logger == logger.GetAdapter():
+		base = logger.FromContext(ctx)
+	default:
+		base = logger.New(config.Logger)
+	}
+
+	requestLogger := base.WithFields(map[string]interface{}{
+		"request_id": requestID,
+		"method":     method,
+		"route":      route,
+	})
+	return logger.IntoContext(ctx, requestLogger)
+}
+
 // sanitizeHeaderValue sanitiza o valor de um header se necessário
 func sanitizeHeaderValue(header, value string, config MiddlewareConfig) string {
 	if isSensitiveHeader(header, config) {
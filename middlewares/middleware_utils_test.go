@@ -0,0 +1,136 @@
+package middlewares
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/victorximenis/logger"
+	"github.com/victorximenis/logger/core"
+)
+
+// logCall representa uma chamada registrada pelo recordingAdapter
+type logCall struct {
+	level  core.Level
+	msg    string
+	fields map[string]interface{}
+}
+
+// recordingAdapter registra as chamadas de log recebidas
+type recordingAdapter struct {
+	mu    sync.Mutex
+	calls []logCall
+}
+
+func (r *recordingAdapter) Log(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, logCall{level: level, msg: msg, fields: fields})
+}
+
+func (r *recordingAdapter) WithContext(ctx context.Context) core.LoggerAdapter {
+	return r
+}
+
+func (r *recordingAdapter) IsLevelEnabled(level core.Level) bool {
+	return true
+}
+
+// messages retorna as chamadas registradas indexadas pela mensagem
+func (r *recordingAdapter) messages() map[string]logCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	byMsg := make(map[string]logCall, len(r.calls))
+	for _, call := range r.calls {
+		byMsg[call.msg] = call
+	}
+	return byMsg
+}
+
+// assertRequestLogging verifica os logs de requisição e resposta e o log
+// registrado pelo handler através do logger da requisição
+func assertRequestLogging(t *testing.T, adapter *recordingAdapter, requestID, method, route string, status int) {
+	t.Helper()
+	calls := adapter.messages()
+
+	started, ok := calls["HTTP request started"]
+	if !ok || started.fields["request_id"] != requestID {
+		t.Errorf("Expected request log with request_id %q, got %v", requestID, started.fields)
+	}
+	completed, ok := calls["HTTP request completed"]
+	if !ok || completed.fields["status"] != status {
+		t.Errorf("Expected response log with status %d, got %v", status, completed.fields)
+	}
+
+	handled, ok := calls["handled"]
+	if !ok {
+		t.Fatalf("Expected handler entry to be written to config.Logger, got %v", calls)
+	}
+	if handled.fields["request_id"] != requestID || handled.fields["method"] != method || handled.fields["route"] != route {
+		t.Errorf("Expected request logger fields, got %v", handled.fields)
+	}
+}
+
+func TestWithRequestLogger(t *testing.T) {
+	configAdapter := &recordingAdapter{}
+	contextAdapter := &recordingAdapter{}
+	ctx := logger.IntoContext(context.Background(), logger.New(contextAdapter))
+
+	// config.Logger tem precedência sobre o logger do contexto
+	requestCtx := withRequestLogger(ctx, DefaultMiddlewareConfig(configAdapter), "req-1", "GET", "/orders")
+	logger.FromContext(requestCtx).Info(requestCtx).Msg("handled")
+	if len(configAdapter.calls) != 1 || len(contextAdapter.calls) != 0 {
+		t.Errorf("Expected entry on config.Logger, got %d / %d calls", len(configAdapter.calls), len(contextAdapter.calls))
+	}
+
+	// Sem config.Logger, o logger do contexto é usado
+	requestCtx = withRequestLogger(ctx, MiddlewareConfig{}, "req-2", "POST", "/orders")
+	logger.FromContext(requestCtx).Info(requestCtx).Msg("handled")
+	if len(contextAdapter.calls) != 1 {
+		t.Fatalf("Expected entry on the context logger, got %d calls", len(contextAdapter.calls))
+	}
+	if fields := contextAdapter.calls[0].fields; fields["request_id"] != "req-2" || fields["method"] != "POST" || fields["route"] != "/orders" {
+		t.Errorf("Expected request logger fields, got %v", fields)
+	}
+}
+
+func TestWithRequestLogger_GlobalAdapter(t *testing.T) {
+	config := logger.NewConfig()
+	config.Output = logger.OutputFile
+	config.LogFilePath = filepath.Join(t.TempDir(), "app.log")
+	config.TenantID = "acme"
+	config.CallerEnabled = true
+	config.Observability.Enabled = false
+	if err := logger.Init(config); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+
+	// Com o adapter global, o logger da requisição deriva do logger global
+	ctx := withRequestLogger(context.Background(), DefaultMiddlewareConfig(logger.GetAdapter()), "req-1", "GET", "/orders")
+	logger.FromContext(ctx).Info(ctx).Msg("handled")
+
+	// RequestLogger tem precedência
+	requestAdapter := &recordingAdapter{}
+	cfg := DefaultMiddlewareConfig(logger.GetAdapter()).WithRequestLogger(logger.New(requestAdapter))
+	ctx = withRequestLogger(context.Background(), cfg, "req-2", "GET", "/orders")
+	logger.FromContext(ctx).Info(ctx).Msg("handled")
+
+	if err := logger.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	content, err := os.ReadFile(config.LogFilePath)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	for _, expected := range []string{`"tenant_id":"acme"`, `"request_id":"req-1"`, `"caller":"`} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected %s in the request logger output, got %q", expected, content)
+		}
+	}
+	if len(requestAdapter.calls) != 1 || requestAdapter.calls[0].fields["request_id"] != "req-2" {
+		t.Errorf("Expected entry on RequestLogger, got %v", requestAdapter.calls)
+	}
+}
@@ -48,6 +48,9 @@ type MultiObservabilityAdapter struct {
 	config      ObservabilityConfig
 	mutex       sync.RWMutex
 	failedCount map[string]int
+	// datadogStarted indica que a integração com Datadog foi inicializada por
+	// este adapter e deve ser encerrada em Close
+	datadogStarted bool
 }
 
 // NewMultiObservabilityAdapter cria um novo adapter multi-observabilidade
@@ -72,6 +75,7 @@ func NewMultiObservabilityAdapter(baseAdapter core.LoggerAdapter, config Observa
 		} else {
 			datadogAdapter := NewDatadogLoggerAdapter(baseAdapter, config.Datadog)
 			adapter.adapters = append(adapter.adapters, datadogAdapter)
+			adapter.datadogStarted = true
 		}
 	}
 
@@ -145,10 +149,29 @@ func (m *MultiObservabilityAdapter) WithContext(ctx context.Context) core.Logger
 	}
 
 	return &MultiObservabilityAdapter{
-		baseAdapter: m.baseAdapter.WithContext(ctx),
-		adapters:    newAdapters,
-		config:      m.config,
-		failedCount: m.failedCount,
+		baseAdapter:    m.baseAdapter.WithContext(ctx),
+		adapters:       newAdapters,
+		config:         m.config,
+		failedCount:    m.failedCount,
+		datadogStarted: m.datadogStarted,
+	}
+}
+
+// WithHooks implementa a interface core.HookableAdapter, adicionando os
+// hooks ao adapter base e a cada adapter de observabilidade, após o
+// enriquecimento de cada um
+func (m *MultiObservabilityAdapter) WithHooks(hooks ...core.Hook) core.LoggerAdapter {
+	newAdapters := make([]core.LoggerAdapter, len(m.adapters))
+	for i, adapter := range m.adapters {
+		newAdapters[i] = core.WithAdapterHooks(adapter, hooks...)
+	}
+
+	return &MultiObservabilityAdapter{
+		baseAdapter:    core.WithAdapterHooks(m.baseAdapter, hooks...),
+		adapters:       newAdapters,
+		config:         m.config,
+		failedCount:    m.failedCount,
+		datadogStarted: m.datadogStarted,
 	}
 }
 
@@ -157,6 +180,27 @@ func (m *MultiObservabilityAdapter) IsLevelEnabled(level core.Level) bool {
 	return m.baseAdapter.IsLevelEnabled(level)
 }
 
+// IsComponentLevelEnabled implementa a interface core.ComponentLevelEnabler
+func (m *MultiObservabilityAdapter) IsComponentLevelEnabled(component string, level core.Level) bool {
+	return core.IsComponentLevelEnabled(m.baseAdapter, component, level)
+}
+
+// Flush implementa a interface core.Flusher
+func (m *MultiObservabilityAdapter) Flush(ctx context.Context) error {
+	return core.FlushAdapter(ctx, m.baseAdapter)
+}
+
+// Close implementa a interface io.Closer, encerrando a integração com
+// Datadog (quando inicializada por este adapter) e o adapter base. Os
+// adapters de observabilidade compartilham o adapter base, que é fechado
+// uma única vez.
+func (m *MultiObservabilityAdapter) Close() error {
+	if m.datadogStarted {
+		StopDatadog()
+	}
+	return core.CloseAdapter(m.baseAdapter)
+}
+
 // GetFailedCounts retorna contadores de falhas por adapter
 func (m *MultiObservabilityAdapter) GetFailedCounts() map[string]int {
 	m.mutex.RLock()
@@ -210,16 +254,16 @@ func (c *CorrelationIDAdapter) Log(ctx context.Context, level core.Level, msg st
 // getOrCreateCorrelationID obtém ou cria um correlation ID
 func (c *CorrelationIDAdapter) getOrCreateCorrelationID(ctx context.Context) string {
 	// Tentar extrair correlation ID existente do contexto
-	if correlationID := c.getContextValue(ctx, "correlation_id"); correlationID != "" {
+	if correlationID, ok := core.GetCorrelationID(ctx); ok {
 		return correlationID
 	}
 
 	// Tentar extrair de outros campos comuns
-	if requestID := c.getContextValue(ctx, "request_id"); requestID != "" {
+	if requestID, ok := core.GetRequestID(ctx); ok {
 		return requestID
 	}
 
-	if traceID := c.getContextValue(ctx, "trace_id"); traceID != "" {
+	if traceID, ok := core.GetTraceID(ctx); ok {
 		return traceID
 	}
 
@@ -227,16 +271,6 @@ func (c *CorrelationIDAdapter) getOrCreateCorrelationID(ctx context.Context) str
 	return uuid.New().String()
 }
 
-// getContextValue extrai um valor do contexto como string
-func (c *CorrelationIDAdapter) getContextValue(ctx context.Context, key string) string {
-	if value := ctx.Value(key); value != nil {
-		if str, ok := value.(string); ok {
-			return str
-		}
-	}
-	return ""
-}
-
 // WithContext implementa a interface LoggerAdapter
 func (c *CorrelationIDAdapter) WithContext(ctx context.Context) core.LoggerAdapter {
 	return &CorrelationIDAdapter{
@@ -245,6 +279,29 @@ func (c *CorrelationIDAdapter) WithContext(ctx context.Context) core.LoggerAdapt
 	}
 }
 
+// WithHooks implementa a interface core.HookableAdapter
+func (c *CorrelationIDAdapter) WithHooks(hooks ...core.Hook) core.LoggerAdapter {
+	return &CorrelationIDAdapter{
+		LoggerAdapter: core.WithAdapterHooks(c.LoggerAdapter, hooks...),
+		config:        c.config,
+	}
+}
+
+// IsComponentLevelEnabled implementa a interface core.ComponentLevelEnabler
+func (c *CorrelationIDAdapter) IsComponentLevelEnabled(component string, level core.Level) bool {
+	return core.IsComponentLevelEnabled(c.LoggerAdapter, component, level)
+}
+
+// Flush implementa a interface core.Flusher
+func (c *CorrelationIDAdapter) Flush(ctx context.Context) error {
+	return core.FlushAdapter(ctx, c.LoggerAdapter)
+}
+
+// Close implementa a interface io.Closer
+func (c *CorrelationIDAdapter) Close() error {
+	return core.CloseAdapter(c.LoggerAdapter)
+}
+
 // Factory functions para diferentes configurações
 
 // NewProductionObservabilityAdapter cria adapter para produção
@@ -315,18 +372,18 @@ func NewCustomObservabilityAdapter(baseAdapter core.LoggerAdapter, config Observ
 }
 
 // ContextWithCorrelationID adiciona correlation ID ao contexto
+//
+// Deprecated: use core.WithCorrelationID.
 func ContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
-	return context.WithValue(ctx, "correlation_id", correlationID)
+	return core.WithCorrelationID(ctx, correlationID)
 }
 
 // CorrelationIDFromContext extrai correlation ID do contexto
+//
+// Deprecated: use core.GetCorrelationID.
 func CorrelationIDFromContext(ctx context.Context) string {
-	if value := ctx.Value("correlation_id"); value != nil {
-		if str, ok := value.(string); ok {
-			return str
-		}
-	}
-	return ""
+	correlationID, _ := core.GetCorrelationID(ctx)
+	return correlationID
 }
 
 // GenerateCorrelationID gera um novo correlation ID
@@ -4,6 +4,9 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/DataDog/datadog-go/v5/statsd"
@@ -29,7 +32,8 @@ type DatadogConfig struct {
 	MetricsEnabled bool
 	// SampleRate define a taxa de amostragem para traces (0.0 a 1.0)
 	SampleRate float64
-	// Tags globais para adicionar a todos os logs/métricas
+	// Tags globais para adicionar a todos os logs/métricas. Nos logs, são
+	// renderizadas no atributo ddtags
 	GlobalTags []string
 }
 
@@ -61,6 +65,9 @@ func InitDatadog(config DatadogConfig) error {
 		return nil
 	}
 
+	datadogMu.Lock()
+	defer datadogMu.Unlock()
+
 	// Inicializar tracer do Datadog se habilitado
 	if config.TracingEnabled {
 		tracer.Start(
@@ -97,44 +104,87 @@ func InitDatadog(config DatadogConfig) error {
 			return err
 		}
 
-		// Armazenar o cliente para uso posterior
-		datadogClient = client
+		// Armazenar o cliente para uso posterior, fechando o anterior
+		if previous := datadogClient.Swap(client); previous != nil {
+			previous.Close()
+		}
 	}
 
+	// Garantir que tracer e cliente de métricas sejam encerrados antes do
+	// encerramento via core.Exit (eventos FATAL)
+	if unregisterDatadogShutdown != nil {
+		unregisterDatadogShutdown()
+	}
+	unregisterDatadogShutdown = core.OnShutdown("datadog", func(ctx context.Context) error {
+		StopDatadog()
+		return nil
+	})
+
 	return nil
 }
 
 // StopDatadog para a integração com Datadog
 func StopDatadog() {
-	if datadogClient != nil {
-		datadogClient.Close()
-		datadogClient = nil
+	datadogMu.Lock()
+	defer datadogMu.Unlock()
+
+	if unregisterDatadogShutdown != nil {
+		unregisterDatadogShutdown()
+		unregisterDatadogShutdown = nil
+	}
+	if client := datadogClient.Swap(nil); client != nil {
+		client.Close()
 	}
 	tracer.Stop()
 }
 
+// DatadogRunning informa se a integração com Datadog inicializada por
+// InitDatadog está ativa, ou seja, ainda não foi encerrada por StopDatadog
+func DatadogRunning() bool {
+	datadogMu.Lock()
+	defer datadogMu.Unlock()
+	return unregisterDatadogShutdown != nil
+}
+
 // DatadogLoggerAdapter aprimora o logger com funcionalidades específicas do Datadog
 type DatadogLoggerAdapter struct {
 	core.LoggerAdapter
 	config DatadogConfig
 }
 
-// NewDatadogLoggerAdapter cria um novo adapter de logger aprimorado com Datadog
+// NewDatadogLoggerAdapter cria um novo adapter de logger aprimorado com
+// Datadog. O enriquecimento é aplicado como um hook do adapter base (ver
+// NewDatadogHook), executado após a formatação quando o base implementa
+// core.HookableAdapter.
 func NewDatadogLoggerAdapter(baseAdapter core.LoggerAdapter, config DatadogConfig) *DatadogLoggerAdapter {
 	return &DatadogLoggerAdapter{
-		LoggerAdapter: baseAdapter,
+		LoggerAdapter: core.WithAdapterHooks(baseAdapter, NewDatadogHook(config)),
 		config:        config,
 	}
 }
 
-// Log implementa a interface LoggerAdapter com melhorias do Datadog
-func (d *DatadogLoggerAdapter) Log(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
+// NewDatadogHook cria um core.Hook que aplica às entradas o mesmo
+// enriquecimento e as mesmas métricas do DatadogLoggerAdapter, para uso em
+// um pipeline de hooks. A integração deve ser iniciada via InitDatadog.
+func NewDatadogHook(config DatadogConfig) core.Hook {
+	enricher := &DatadogLoggerAdapter{config: config}
+	return core.HookFunc(func(entry *core.Entry) bool {
+		enricher.enrich(entry.Context, entry.Level, entry.Fields)
+		return true
+	})
+}
+
+// enrich adiciona os IDs de trace, as tags do Datadog e envia as métricas
+// de contagem de logs
+func (d *DatadogLoggerAdapter) enrich(ctx context.Context, level core.Level, fields map[string]interface{}) {
 	// Extrair trace e span IDs do contexto se disponível
 	if d.config.TracingEnabled {
 		if span, ok := tracer.SpanFromContext(ctx); ok {
 			spanContext := span.Context()
-			fields["dd.trace_id"] = spanContext.TraceID()
-			fields["dd.span_id"] = spanContext.SpanID()
+			// IDs de 64 bits renderizados como string, como no dd-trace-go,
+			// para não perder precisão em parsers JSON
+			fields["dd.trace_id"] = strconv.FormatUint(spanContext.TraceID(), 10)
+			fields["dd.span_id"] = strconv.FormatUint(spanContext.SpanID(), 10)
 		}
 	}
 
@@ -143,13 +193,20 @@ func (d *DatadogLoggerAdapter) Log(ctx context.Context, level core.Level, msg st
 	fields["dd.env"] = d.config.Environment
 	fields["dd.version"] = d.config.Version
 
+	// Tags globais no atributo reservado ddtags ("key:value" separados por
+	// vírgula), o mesmo formato de DD_TAGS
+	if len(d.config.GlobalTags) > 0 {
+		fields["ddtags"] = strings.Join(d.config.GlobalTags, ",")
+	}
+
 	// Adicionar timestamp no formato esperado pelo Datadog
 	if _, exists := fields["timestamp"]; !exists {
 		fields["timestamp"] = time.Now().UTC().Format(time.RFC3339Nano)
 	}
 
 	// Enviar métricas baseadas no nível de log
-	if d.config.MetricsEnabled && datadogClient != nil {
+	client := datadogClient.Load()
+	if d.config.MetricsEnabled && client != nil {
 		tags := []string{
 			"level:" + level.String(),
 			"service:" + d.config.ServiceName,
@@ -157,16 +214,13 @@ func (d *DatadogLoggerAdapter) Log(ctx context.Context, level core.Level, msg st
 		}
 
 		// Incrementar contador de logs por nível
-		datadogClient.Incr("logger.log_count", tags, 1)
+		client.Incr("logger.log_count", tags, 1)
 
 		// Incrementar contador de erros se for nível ERROR ou FATAL
 		if level >= core.ERROR {
-			datadogClient.Incr("logger.error_count", tags, 1)
+			client.Incr("logger.error_count", tags, 1)
 		}
 	}
-
-	// Encaminhar para o adapter base
-	d.LoggerAdapter.Log(ctx, level, msg, fields)
 }
 
 // WithContext implementa a interface LoggerAdapter
@@ -177,39 +231,71 @@ func (d *DatadogLoggerAdapter) WithContext(ctx context.Context) core.LoggerAdapt
 	}
 }
 
+// WithHooks implementa a interface core.HookableAdapter. Os hooks são
+// executados após o enriquecimento do Datadog.
+func (d *DatadogLoggerAdapter) WithHooks(hooks ...core.Hook) core.LoggerAdapter {
+	return &DatadogLoggerAdapter{
+		LoggerAdapter: core.WithAdapterHooks(d.LoggerAdapter, hooks...),
+		config:        d.config,
+	}
+}
+
 // IsLevelEnabled implementa a interface LoggerAdapter
 func (d *DatadogLoggerAdapter) IsLevelEnabled(level core.Level) bool {
 	return d.LoggerAdapter.IsLevelEnabled(level)
 }
 
-// Cliente global do Datadog para métricas
-var datadogClient *statsd.Client
+// IsComponentLevelEnabled implementa a interface core.ComponentLevelEnabler
+func (d *DatadogLoggerAdapter) IsComponentLevelEnabled(component string, level core.Level) bool {
+	return core.IsComponentLevelEnabled(d.LoggerAdapter, component, level)
+}
+
+// Flush implementa a interface core.Flusher
+func (d *DatadogLoggerAdapter) Flush(ctx context.Context) error {
+	return core.FlushAdapter(ctx, d.LoggerAdapter)
+}
+
+// Close implementa a interface io.Closer. A integração global com Datadog
+// é encerrada por StopDatadog ou pelo MultiObservabilityAdapter.
+func (d *DatadogLoggerAdapter) Close() error {
+	return core.CloseAdapter(d.LoggerAdapter)
+}
+
+// Cliente global do Datadog para métricas. Substituído por InitDatadog e
+// StopDatadog sob datadogMu e lido sem lock no caminho de log.
+var datadogClient atomic.Pointer[statsd.Client]
+
+// unregisterDatadogShutdown remove o shutdown hook registrado por InitDatadog
+var unregisterDatadogShutdown func()
+
+// datadogMu serializa InitDatadog e StopDatadog
+var datadogMu sync.Mutex
 
 // IncrementCounter incrementa um contador no Datadog
 func IncrementCounter(name string, tags []string) {
-	if datadogClient != nil {
-		datadogClient.Incr(name, tags, 1)
+	if client := datadogClient.Load(); client != nil {
+		client.Incr(name, tags, 1)
 	}
 }
 
 // RecordDuration registra uma duração no Datadog
 func RecordDuration(name string, duration time.Duration, tags []string) {
-	if datadogClient != nil {
-		datadogClient.Timing(name, duration, tags, 1)
+	if client := datadogClient.Load(); client != nil {
+		client.Timing(name, duration, tags, 1)
 	}
 }
 
 // RecordGauge registra um valor gauge no Datadog
 func RecordGauge(name string, value float64, tags []string) {
-	if datadogClient != nil {
-		datadogClient.Gauge(name, value, tags, 1)
+	if client := datadogClient.Load(); client != nil {
+		client.Gauge(name, value, tags, 1)
 	}
 }
 
 // RecordHistogram registra um valor histogram no Datadog
 func RecordHistogram(name string, value float64, tags []string) {
-	if datadogClient != nil {
-		datadogClient.Histogram(name, value, tags, 1)
+	if client := datadogClient.Load(); client != nil {
+		client.Histogram(name, value, tags, 1)
 	}
 }
 
@@ -223,9 +309,16 @@ func SpanFromContext(ctx context.Context) (tracer.Span, bool) {
 	return tracer.SpanFromContext(ctx)
 }
 
-// ContextWithSpan adiciona um span ao contexto
+// ContextWithSpan adiciona um span ao contexto, propagando também os seus
+// trace e span IDs via core.WithTraceID e core.WithSpanID
 func ContextWithSpan(ctx context.Context, span tracer.Span) context.Context {
-	return tracer.ContextWithSpan(ctx, span)
+	ctx = tracer.ContextWithSpan(ctx, span)
+	if span == nil {
+		return ctx
+	}
+	spanContext := span.Context()
+	ctx = core.WithTraceID(ctx, strconv.FormatUint(spanContext.TraceID(), 10))
+	return core.WithSpanID(ctx, strconv.FormatUint(spanContext.SpanID(), 10))
 }
 
 // Funções auxiliares
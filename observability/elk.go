@@ -2,7 +2,10 @@ package observability
 
 import (
 	"context"
+	"net"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -48,7 +51,9 @@ func DefaultELKConfig() ELKConfig {
 	}
 }
 
-// ELKLoggerAdapter aprimora o logger com funcionalidades específicas do ELK
+// ELKLoggerAdapter aprimora o logger com funcionalidades específicas do ELK.
+// O enriquecimento é aplicado como um hook do adapter base (ver NewELKHook),
+// executado após a formatação quando o base implementa core.HookableAdapter.
 type ELKLoggerAdapter struct {
 	core.LoggerAdapter
 	config ELKConfig
@@ -57,36 +62,40 @@ type ELKLoggerAdapter struct {
 // NewELKLoggerAdapter cria um novo adapter de logger aprimorado com ELK
 func NewELKLoggerAdapter(baseAdapter core.LoggerAdapter, config ELKConfig) *ELKLoggerAdapter {
 	return &ELKLoggerAdapter{
-		LoggerAdapter: baseAdapter,
+		LoggerAdapter: core.WithAdapterHooks(baseAdapter, NewELKHook(config)),
 		config:        config,
 	}
 }
 
-// Log implementa a interface LoggerAdapter com melhorias do ELK
-func (e *ELKLoggerAdapter) Log(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
-	// Criar uma cópia dos campos para não modificar o original
-	enrichedFields := make(map[string]interface{})
-	for k, v := range fields {
-		enrichedFields[k] = v
-	}
+// NewELKHook cria um core.Hook que aplica às entradas o mesmo
+// enriquecimento do ELKLoggerAdapter, para uso em um pipeline de hooks
+func NewELKHook(config ELKConfig) core.Hook {
+	enricher := &ELKLoggerAdapter{config: config}
+	return core.HookFunc(func(entry *core.Entry) bool {
+		enricher.enrich(entry.Context, entry.Level, entry.Message, entry.Fields)
+		return true
+	})
+}
 
+// enrich adiciona os campos personalizados e aplica o mapeamento configurado
+func (e *ELKLoggerAdapter) enrich(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
 	// Adicionar campos personalizados
 	for k, v := range e.config.CustomFields {
-		enrichedFields[k] = v
+		fields[k] = v
 	}
 
 	if e.config.EnableECSMapping {
-		e.applyECSMapping(ctx, level, msg, enrichedFields)
+		e.applyECSMapping(ctx, level, msg, fields)
 	} else {
-		e.applyBasicMapping(ctx, level, msg, enrichedFields)
+		e.applyBasicMapping(ctx, level, msg, fields)
 	}
-
-	// Encaminhar para o adapter base
-	e.LoggerAdapter.Log(ctx, level, msg, enrichedFields)
 }
 
 // applyECSMapping aplica mapeamento para Elastic Common Schema (ECS)
 func (e *ELKLoggerAdapter) applyECSMapping(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
+	// Mover os campos base do formatter para os nomes ECS
+	e.mapBaseFieldsToECS(fields)
+
 	// Timestamp no formato ECS
 	if _, exists := fields["@timestamp"]; !exists {
 		fields["@timestamp"] = time.Now().UTC().Format(time.RFC3339Nano)
@@ -100,7 +109,8 @@ func (e *ELKLoggerAdapter) applyECSMapping(ctx context.Context, level core.Level
 	fields["log.level"] = strings.ToLower(level.String())
 	fields["log.logger"] = "application"
 
-	// Service information
+	// Service information. Os valores do ELKConfig têm precedência sobre os
+	// do formatter.
 	if e.config.ServiceName != "" {
 		fields["service.name"] = e.config.ServiceName
 	}
@@ -148,74 +158,91 @@ func (e *ELKLoggerAdapter) applyBasicMapping(ctx context.Context, level core.Lev
 	e.extractContextFields(ctx, fields)
 }
 
-// extractContextFields extrai campos do contexto
-func (e *ELKLoggerAdapter) extractContextFields(ctx context.Context, fields map[string]interface{}) {
-	// Extrair user ID do contexto
-	if userID := e.getContextValue(ctx, "user_id"); userID != "" {
-		if e.config.EnableECSMapping {
-			fields["user.id"] = userID
-		} else {
-			fields["user_id"] = userID
-		}
-	}
+// ecsBaseFields mapeia os campos base do core.Formatter para os nomes ECS
+var ecsBaseFields = map[string]string{
+	"timestamp": "@timestamp",
+	"service":   "service.name",
+	"env":       "service.environment",
+	"tenant":    "labels.tenant",
+}
 
-	// Extrair trace ID do contexto
-	if traceID := e.getContextValue(ctx, "trace_id"); traceID != "" {
-		if e.config.EnableECSMapping {
-			fields["trace.id"] = traceID
-		} else {
-			fields["trace_id"] = traceID
+// mapBaseFieldsToECS renomeia os campos base adicionados pelo formatter,
+// presentes quando o enriquecimento é executado como hook após a formatação.
+// O campo level é substituído por log.level.
+func (e *ELKLoggerAdapter) mapBaseFieldsToECS(fields map[string]interface{}) {
+	for key, name := range ecsBaseFields {
+		value, exists := fields[key]
+		if !exists {
+			continue
 		}
-	}
-
-	// Extrair span ID do contexto
-	if spanID := e.getContextValue(ctx, "span_id"); spanID != "" {
-		if e.config.EnableECSMapping {
-			fields["span.id"] = spanID
-		} else {
-			fields["span_id"] = spanID
+		if _, mapped := fields[name]; !mapped {
+			fields[name] = value
 		}
+		delete(fields, key)
 	}
+	delete(fields, "level")
+}
 
-	// Extrair request ID do contexto
-	if requestID := e.getContextValue(ctx, "request_id"); requestID != "" {
-		if e.config.EnableECSMapping {
-			fields["http.request.id"] = requestID
-		} else {
-			fields["request_id"] = requestID
-		}
-	}
+// ecsContextFields mapeia os campos de contexto padrão para os nomes ECS.
+// Campos registrados via core.RegisterContextField sem equivalente ECS são
+// mapeados para labels.<campo>.
+var ecsContextFields = map[string]string{
+	"user_id":        "user.id",
+	"trace_id":       "trace.id",
+	"span_id":        "span.id",
+	"request_id":     "http.request.id",
+	"correlation_id": "labels.correlation_id",
+	"session_id":     "user.session.id",
+}
 
-	// Extrair correlation ID do contexto
-	if correlationID := e.getContextValue(ctx, "correlation_id"); correlationID != "" {
+// extractContextFields extrai os campos do contexto pelos extractors
+// registrados no core
+func (e *ELKLoggerAdapter) extractContextFields(ctx context.Context, fields map[string]interface{}) {
+	for key, value := range core.ExtractContextFields(ctx) {
 		if e.config.EnableECSMapping {
-			fields["labels.correlation_id"] = correlationID
-		} else {
-			fields["correlation_id"] = correlationID
+			// Remover o campo adicionado pelo formatter com o mesmo valor
+			if existing, exists := fields[key]; exists && reflect.DeepEqual(existing, value) {
+				delete(fields, key)
+			}
+			key = ecsContextField(key)
 		}
+		fields[key] = value
 	}
+}
 
-	// Extrair session ID do contexto
-	if sessionID := e.getContextValue(ctx, "session_id"); sessionID != "" {
-		if e.config.EnableECSMapping {
-			fields["user.session.id"] = sessionID
-		} else {
-			fields["session_id"] = sessionID
-		}
+// ecsContextField retorna o nome ECS do campo de contexto
+func ecsContextField(key string) string {
+	if name, ok := ecsContextFields[key]; ok {
+		return name
 	}
+	return "labels." + key
 }
 
 // mapExistingFieldsToECS mapeia campos existentes para ECS
 func (e *ELKLoggerAdapter) mapExistingFieldsToECS(fields map[string]interface{}) {
-	// Mapear campos de erro
-	if err, exists := fields["error"]; exists {
+	// Mapear campos de erro. error.type e error.stack_trace já seguem o ECS;
+	// error.chain não tem equivalente e é mantido como extensão
+	if err, exists := fields[core.ErrorKey]; exists {
+		if e, ok := err.(error); ok {
+			err = e.Error()
+		}
 		fields["error.message"] = err
-		delete(fields, "error")
+		delete(fields, core.ErrorKey)
+	}
+	if trace, exists := fields["stack_trace"]; exists {
+		if _, ok := fields[core.ErrorStackTraceKey]; !ok {
+			fields[core.ErrorStackTraceKey] = trace
+		}
+		delete(fields, "stack_trace")
 	}
 
 	// Mapear campos de duração
 	if duration, exists := fields["duration"]; exists {
-		fields["event.duration"] = duration
+		if d, ok := duration.(time.Duration); ok {
+			fields["event.duration"] = d.Nanoseconds() // ECS usa nanoseconds
+		} else {
+			fields["event.duration"] = duration
+		}
 	}
 	if durationMs, exists := fields["duration_ms"]; exists {
 		// Converter milliseconds para nanoseconds (ECS usa nanoseconds)
@@ -245,6 +272,9 @@ func (e *ELKLoggerAdapter) mapExistingFieldsToECS(fields map[string]interface{})
 		delete(fields, "user_agent")
 	}
 	if remoteIP, exists := fields["remote_ip"]; exists {
+		if ip, ok := remoteIP.(net.IP); ok {
+			remoteIP = ip.String()
+		}
 		fields["client.ip"] = remoteIP
 		delete(fields, "remote_ip")
 	}
@@ -254,16 +284,27 @@ func (e *ELKLoggerAdapter) mapExistingFieldsToECS(fields map[string]interface{})
 		fields["labels.component"] = component
 		delete(fields, "component")
 	}
-}
 
-// getContextValue extrai um valor do contexto como string
-func (e *ELKLoggerAdapter) getContextValue(ctx context.Context, key string) string {
-	if value := ctx.Value(key); value != nil {
-		if str, ok := value.(string); ok {
-			return str
+	// Mapear campos de origem da chamada
+	if caller, exists := fields[core.CallerKey]; exists {
+		if str, ok := caller.(string); ok {
+			if idx := strings.LastIndex(str, ":"); idx > 0 {
+				fields["log.origin.file.name"] = str[:idx]
+				if line, err := strconv.Atoi(str[idx+1:]); err == nil {
+					fields["log.origin.file.line"] = line
+				}
+			} else {
+				fields["log.origin.file.name"] = str
+			}
+		} else {
+			fields["log.origin.file.name"] = caller
 		}
+		delete(fields, core.CallerKey)
+	}
+	if function, exists := fields[core.FunctionKey]; exists {
+		fields["log.origin.function"] = function
+		delete(fields, core.FunctionKey)
 	}
-	return ""
 }
 
 // WithContext implementa a interface LoggerAdapter
@@ -274,11 +315,35 @@ func (e *ELKLoggerAdapter) WithContext(ctx context.Context) core.LoggerAdapter {
 	}
 }
 
+// WithHooks implementa a interface core.HookableAdapter. Os hooks são
+// executados após o enriquecimento do ELK.
+func (e *ELKLoggerAdapter) WithHooks(hooks ...core.Hook) core.LoggerAdapter {
+	return &ELKLoggerAdapter{
+		LoggerAdapter: core.WithAdapterHooks(e.LoggerAdapter, hooks...),
+		config:        e.config,
+	}
+}
+
 // IsLevelEnabled implementa a interface LoggerAdapter
 func (e *ELKLoggerAdapter) IsLevelEnabled(level core.Level) bool {
 	return e.LoggerAdapter.IsLevelEnabled(level)
 }
 
+// IsComponentLevelEnabled implementa a interface core.ComponentLevelEnabler
+func (e *ELKLoggerAdapter) IsComponentLevelEnabled(component string, level core.Level) bool {
+	return core.IsComponentLevelEnabled(e.LoggerAdapter, component, level)
+}
+
+// Flush implementa a interface core.Flusher
+func (e *ELKLoggerAdapter) Flush(ctx context.Context) error {
+	return core.FlushAdapter(ctx, e.LoggerAdapter)
+}
+
+// Close implementa a interface io.Closer
+func (e *ELKLoggerAdapter) Close() error {
+	return core.CloseAdapter(e.LoggerAdapter)
+}
+
 // Funções auxiliares
 
 // parseCustomFields parseia campos personalizados da variável de ambiente
@@ -321,6 +386,17 @@ func GetECSTemplate() map[string]interface{} {
 				"index.refresh_interval": "5s",
 			},
 			"mappings": map[string]interface{}{
+				// Campos de contexto personalizados são mapeados para labels.*
+				"dynamic_templates": []map[string]interface{}{
+					{
+						"labels_as_keyword": map[string]interface{}{
+							"path_match": "labels.*",
+							"mapping": map[string]interface{}{
+								"type": "keyword",
+							},
+						},
+					},
+				},
 				"properties": map[string]interface{}{
 					"@timestamp": map[string]interface{}{
 						"type": "date",
@@ -352,6 +428,12 @@ func GetECSTemplate() map[string]interface{} {
 					"span.id": map[string]interface{}{
 						"type": "keyword",
 					},
+					"http.request.id": map[string]interface{}{
+						"type": "keyword",
+					},
+					"user.session.id": map[string]interface{}{
+						"type": "keyword",
+					},
 					"http.request.method": map[string]interface{}{
 						"type": "keyword",
 					},
@@ -370,6 +452,25 @@ func GetECSTemplate() map[string]interface{} {
 					"error.message": map[string]interface{}{
 						"type": "text",
 					},
+					"error.type": map[string]interface{}{
+						"type": "keyword",
+					},
+					"error.stack_trace": map[string]interface{}{
+						"type": "wildcard",
+					},
+					"error.chain": map[string]interface{}{
+						"type":    "object",
+						"enabled": false,
+					},
+					"log.origin.file.name": map[string]interface{}{
+						"type": "keyword",
+					},
+					"log.origin.file.line": map[string]interface{}{
+						"type": "integer",
+					},
+					"log.origin.function": map[string]interface{}{
+						"type": "keyword",
+					},
 				},
 			},
 		},
@@ -0,0 +1,154 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/victorximenis/logger/core"
+)
+
+// PanicKey é a chave do campo com o valor recuperado do panic
+const PanicKey = "panic"
+
+// RecoverAction define o que acontece após o registro de um panic recuperado
+type RecoverAction int
+
+const (
+	// RecoverSwallow registra o panic e continua a execução (padrão)
+	RecoverSwallow RecoverAction = iota
+	// RecoverRepanic registra o panic e dispara novamente com o mesmo valor
+	RecoverRepanic
+	// RecoverExit registra o panic e chama ExitFunc com ExitCode
+	RecoverExit
+)
+
+// RecoverOptions define o comportamento de Recover e Go
+type RecoverOptions struct {
+	// Logger é usado para registrar o panic (padrão: logger global)
+	Logger Logger
+	// Level é o nível da entrada de log. DefaultRecoverOptions usa ERROR;
+	// o valor zero é INFO e é respeitado como qualquer outro nível. Entradas
+	// FATAL encerram o processo ao serem registradas, antes da Action.
+	Level core.Level
+	// Action define o que acontece após o registro do panic
+	Action RecoverAction
+	// ExitFunc é chamada com ExitCode quando Action é RecoverExit (padrão:
+	// core.Exit, que executa os shutdown hooks antes de encerrar)
+	ExitFunc func(code int)
+	// ExitCode é o código de saída usado com RecoverExit. O valor zero é
+	// tratado como 1, para que o encerramento após um panic não indique sucesso.
+	ExitCode int
+}
+
+// DefaultRecoverOptions retorna as opções padrão de recuperação de panics
+func DefaultRecoverOptions() RecoverOptions {
+	return RecoverOptions{
+		Level:    core.ERROR,
+		Action:   RecoverSwallow,
+		ExitFunc: core.Exit,
+		ExitCode: 1,
+	}
+}
+
+// WithLogger configura o logger usado para registrar o panic
+func (o RecoverOptions) WithLogger(logger Logger) RecoverOptions {
+	o.Logger = logger
+	return o
+}
+
+// WithLevel configura o nível da entrada de log
+func (o RecoverOptions) WithLevel(level core.Level) RecoverOptions {
+	o.Level = level
+	return o
+}
+
+// WithAction configura o que acontece após o registro do panic
+func (o RecoverOptions) WithAction(action RecoverAction) RecoverOptions {
+	o.Action = action
+	return o
+}
+
+// WithExitFunc configura a função chamada com RecoverExit
+func (o RecoverOptions) WithExitFunc(exitFunc func(code int)) RecoverOptions {
+	o.ExitFunc = exitFunc
+	return o
+}
+
+// WithExitCode configura o código de saída usado com RecoverExit (0 é
+// tratado como 1)
+func (o RecoverOptions) WithExitCode(code int) RecoverOptions {
+	o.ExitCode = code
+	return o
+}
+
+// Recover recupera um panic em andamento e o registra com o valor, o stack
+// completo da goroutine e os IDs de trace e correlação do contexto. Deve
+// ser chamada diretamente via defer.
+//
+// Exemplo:
+//
+//	func (w *Worker) process(ctx context.Context, job Job) {
+//		defer logger.Recover(ctx, logger.DefaultRecoverOptions())
+//		...
+//	}
+func Recover(ctx context.Context, opts RecoverOptions) {
+	if r := recover(); r != nil {
+		handlePanic(ctx, r, opts)
+	}
+}
+
+// Go executa fn em uma nova goroutine protegida por Recover com as opções
+// padrão, evitando que um panic encerre o processo sem registro.
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	GoWithOptions(ctx, DefaultRecoverOptions(), fn)
+}
+
+// GoWithOptions executa fn em uma nova goroutine protegida por Recover com
+// as opções especificadas
+func GoWithOptions(ctx context.Context, opts RecoverOptions, fn func(ctx context.Context)) {
+	go func() {
+		defer Recover(ctx, opts)
+		fn(ctx)
+	}()
+}
+
+// handlePanic registra o panic recuperado e aplica a ação configurada
+func handlePanic(ctx context.Context, r interface{}, opts RecoverOptions) {
+	log := opts.Logger
+	if log == nil {
+		log = GetLogger()
+	}
+
+	event := log.Log(ctx, opts.Level)
+	if err, ok := r.(error); ok {
+		event = event.Err(err)
+	}
+	event = event.
+		Str(PanicKey, fmt.Sprint(r)).
+		Str(core.ErrorTypeKey, fmt.Sprintf("%T", r)).
+		Str(core.ErrorStackTraceKey, string(debug.Stack()))
+
+	if traceID, ok := core.GetTraceID(ctx); ok {
+		event = event.Str("trace_id", traceID)
+	}
+	if correlationID, ok := core.GetCorrelationID(ctx); ok {
+		event = event.Str("correlation_id", correlationID)
+	}
+	event.Msg("Recovered from panic")
+
+	switch opts.Action {
+	case RecoverRepanic:
+		panic(r)
+	case RecoverExit:
+		exitFunc := opts.ExitFunc
+		if exitFunc == nil {
+			exitFunc = core.Exit
+		}
+		exitCode := opts.ExitCode
+		if exitCode == 0 {
+			exitCode = 1
+		}
+		exitFunc(exitCode)
+	}
+}
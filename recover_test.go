@@ -0,0 +1,172 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/victorximenis/logger/core"
+)
+
+// syncAdapter encaminha as chamadas de log para um canal, permitindo
+// aguardar entradas registradas em outras goroutines
+type syncAdapter struct {
+	calls chan logCall
+}
+
+func (s *syncAdapter) Log(ctx context.Context, level core.Level, msg string, fields map[string]interface{}) {
+	s.calls <- logCall{ctx: ctx, level: level, msg: msg, fields: fields}
+}
+
+func (s *syncAdapter) WithContext(ctx context.Context) LoggerAdapter {
+	return s
+}
+
+func (s *syncAdapter) IsLevelEnabled(level core.Level) bool {
+	return true
+}
+
+func TestRecover_Swallow(t *testing.T) {
+	adapter := &mockAdapter{}
+	opts := DefaultRecoverOptions().WithLogger(New(adapter))
+
+	ctx := core.WithTraceID(context.Background(), "trace-1")
+	ctx = core.WithCorrelationID(ctx, "corr-1")
+
+	func() {
+		defer Recover(ctx, opts)
+		panic(errors.New("nil map write"))
+	}()
+
+	if len(adapter.logCalls) != 1 {
+		t.Fatalf("Expected 1 log call, got %d", len(adapter.logCalls))
+	}
+	call := adapter.logCalls[0]
+	if call.level != core.ERROR {
+		t.Errorf("Expected ERROR level, got %v", call.level)
+	}
+
+	fields := call.fields
+	if fields[PanicKey] != "nil map write" {
+		t.Errorf("Expected panic value, got %v", fields[PanicKey])
+	}
+	if fields[core.ErrorKey] != "nil map write" {
+		t.Errorf("Expected error field for error panics, got %v", fields[core.ErrorKey])
+	}
+	if fields["trace_id"] != "trace-1" || fields["correlation_id"] != "corr-1" {
+		t.Errorf("Expected trace and correlation IDs, got %v / %v", fields["trace_id"], fields["correlation_id"])
+	}
+	stack, _ := fields[core.ErrorStackTraceKey].(string)
+	if !strings.Contains(stack, "TestRecover_Swallow") {
+		t.Errorf("Expected goroutine stack with the panicking function, got:\n%s", stack)
+	}
+}
+
+func TestRecover_ZeroLevelIsInfo(t *testing.T) {
+	adapter := &mockAdapter{}
+	opts := RecoverOptions{Logger: New(adapter)}
+
+	func() {
+		defer Recover(context.Background(), opts)
+		panic("boom")
+	}()
+
+	// O valor zero é INFO e não é substituído pelo padrão ERROR
+	if len(adapter.logCalls) != 1 || adapter.logCalls[0].level != core.INFO {
+		t.Errorf("Expected zero-value Level to log at INFO, got %v", adapter.logCalls)
+	}
+}
+
+func TestRecover_Repanic(t *testing.T) {
+	adapter := &mockAdapter{}
+	opts := DefaultRecoverOptions().
+		WithLogger(New(adapter)).
+		WithAction(RecoverRepanic)
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Expected re-panic with 'boom', got %v", r)
+		}
+		if len(adapter.logCalls) != 1 {
+			t.Errorf("Expected panic to be logged before re-panic, got %d calls", len(adapter.logCalls))
+		}
+	}()
+
+	func() {
+		defer Recover(context.Background(), opts)
+		panic("boom")
+	}()
+	t.Error("Expected Recover to re-panic")
+}
+
+func TestRecover_Exit(t *testing.T) {
+	adapter := &mockAdapter{}
+	fatalExits := 0
+	restore := core.SetExitFunc(func(code int) { fatalExits++ })
+	defer restore()
+
+	exitCode := -1
+	opts := DefaultRecoverOptions().
+		WithLogger(New(adapter)).
+		WithLevel(core.FATAL).
+		WithAction(RecoverExit).
+		WithExitCode(3).
+		WithExitFunc(func(code int) { exitCode = code })
+
+	func() {
+		defer Recover(context.Background(), opts)
+		panic("unrecoverable")
+	}()
+
+	if exitCode != 3 {
+		t.Errorf("Expected exit code 3, got %d", exitCode)
+	}
+	if fatalExits != 1 {
+		t.Errorf("Expected the FATAL entry to call core.Exit once, got %d", fatalExits)
+	}
+	if len(adapter.logCalls) != 1 || adapter.logCalls[0].level != core.FATAL {
+		t.Errorf("Expected a FATAL entry, got %v", adapter.logCalls)
+	}
+}
+
+func TestRecover_ExitZeroValueOptions(t *testing.T) {
+	exitCode := -1
+	restore := core.SetExitFunc(func(code int) { exitCode = code })
+	defer restore()
+
+	// Opções sem DefaultRecoverOptions: ExitFunc nil e ExitCode zero
+	opts := RecoverOptions{Logger: New(&mockAdapter{}), Action: RecoverExit}
+
+	func() {
+		defer Recover(context.Background(), opts)
+		panic("unrecoverable")
+	}()
+
+	if exitCode != 1 {
+		t.Errorf("Expected zero ExitCode to exit with code 1, got %d", exitCode)
+	}
+}
+
+func TestGo(t *testing.T) {
+	adapter := &syncAdapter{calls: make(chan logCall, 1)}
+	opts := DefaultRecoverOptions().WithLogger(New(adapter))
+
+	GoWithOptions(context.Background(), opts, func(ctx context.Context) {
+		var m map[string]int
+		m["key"] = 1
+	})
+
+	select {
+	case call := <-adapter.calls:
+		if call.msg != "Recovered from panic" {
+			t.Errorf("Expected recovery entry, got %q", call.msg)
+		}
+		if panicValue, _ := call.fields[PanicKey].(string); !strings.Contains(panicValue, "nil map") {
+			t.Errorf("Expected nil map panic, got %v", call.fields[PanicKey])
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected goroutine panic to be logged")
+	}
+}
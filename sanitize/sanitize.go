@@ -2,8 +2,10 @@ package sanitize
 
 import (
 	"encoding/json"
+	"net"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // SensitiveFieldConfig define como tratar campos sensíveis
@@ -55,6 +57,15 @@ func SanitizeString(data string, config SensitiveFieldConfig) string {
 	return sanitizeString(data, "", config)
 }
 
+// SanitizeFields sanitiza um map de campos de log sem convertê-lo para JSON,
+// preservando os tipos renderizados pelos encoders nativos do adapter
+// (time.Duration, time.Time, net.IP etc.). Erros são substituídos pela
+// mensagem sanitizada e valores de outros tipos são sanitizados a partir da
+// sua representação JSON.
+func SanitizeFields(fields map[string]interface{}, config SensitiveFieldConfig) map[string]interface{} {
+	return sanitizeMap(fields, "", config)
+}
+
 // sanitizeValue sanitiza recursivamente valores em uma estrutura de dados
 func sanitizeValue(data interface{}, path string, config SensitiveFieldConfig) interface{} {
 	switch v := data.(type) {
@@ -64,9 +75,36 @@ func sanitizeValue(data interface{}, path string, config SensitiveFieldConfig) i
 		return sanitizeArray(v, path, config)
 	case string:
 		return sanitizeString(v, path, config)
-	default:
+	case []string:
+		result := make([]string, len(v))
+		for i, s := range v {
+			result[i] = sanitizeString(s, path, config)
+		}
+		return result
+	case []byte:
+		return sanitizeString(string(v), path, config)
+	case error:
+		return sanitizeString(v.Error(), path, config)
+	case nil, bool, int, int32, int64, uint64, float32, float64, []int,
+		time.Duration, time.Time, net.IP:
 		return v
+	default:
+		return sanitizeJSONValue(v, path, config)
+	}
+}
+
+// sanitizeJSONValue sanitiza um valor de tipo arbitrário a partir da sua
+// representação JSON, mantendo o valor original se a conversão falhar
+func sanitizeJSONValue(data interface{}, path string, config SensitiveFieldConfig) interface{} {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return data
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(jsonData, &decoded); err != nil {
+		return data
 	}
+	return sanitizeValue(decoded, path, config)
 }
 
 // sanitizeMap sanitiza um mapa
@@ -0,0 +1,149 @@
+package logger
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/victorximenis/logger/core"
+)
+
+// SignalToggleOptions define as opções do controle de verbosidade por sinais
+type SignalToggleOptions struct {
+	// AutoRevertAfter define após quanto tempo o nível configurado é restaurado
+	// automaticamente depois da última alteração via SIGUSR1 (0 desabilita)
+	AutoRevertAfter time.Duration
+}
+
+// DefaultSignalToggleOptions retorna as opções padrão, sem restauração automática
+func DefaultSignalToggleOptions() SignalToggleOptions {
+	return SignalToggleOptions{
+		AutoRevertAfter: 0,
+	}
+}
+
+// WithAutoRevertAfter configura a restauração automática do nível configurado
+func (o SignalToggleOptions) WithAutoRevertAfter(d time.Duration) SignalToggleOptions {
+	o.AutoRevertAfter = d
+	return o
+}
+
+// EnableSignalLevelToggle habilita o controle de verbosidade do logger global
+// por sinais: SIGUSR1 reduz o nível em um passo (em direção a DEBUG) e SIGUSR2
+// restaura o nível configurado. Retorna uma função que desabilita o controle.
+// Em plataformas sem SIGUSR1/SIGUSR2 não tem efeito.
+//
+// Exemplo:
+//
+//	stop := logger.EnableSignalLevelToggle()
+//	defer stop()
+//
+//	// kill -USR1 <pid>  -> INFO para DEBUG
+//	// kill -USR2 <pid>  -> volta para o nível configurado
+func EnableSignalLevelToggle() func() {
+	return EnableSignalLevelToggleWithOptions(DefaultSignalToggleOptions())
+}
+
+// verbosityOrder lista os níveis do mais verboso para o menos verboso
+var verbosityOrder = []core.Level{core.DEBUG, core.INFO, core.WARN, core.ERROR, core.FATAL, core.PANIC}
+
+// moreVerboseLevel retorna o nível imediatamente mais verboso que level
+func moreVerboseLevel(level core.Level) (core.Level, bool) {
+	for i := len(verbosityOrder) - 1; i >= 0; i-- {
+		if verbosityOrder[i] < level {
+			return verbosityOrder[i], true
+		}
+	}
+	return level, false
+}
+
+// levelToggle aplica as transições de nível disparadas por sinais
+type levelToggle struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	opts  SignalToggleOptions
+	// revert recebe o disparo da restauração automática
+	revert chan struct{}
+}
+
+// newLevelToggle cria um levelToggle com as opções especificadas
+func newLevelToggle(opts SignalToggleOptions) *levelToggle {
+	return &levelToggle{
+		opts:   opts,
+		revert: make(chan struct{}, 1),
+	}
+}
+
+// stepDown reduz o nível global em um passo em direção a DEBUG
+func (t *levelToggle) stepDown(signal string) {
+	previous := globalLevel.Level()
+	next, ok := moreVerboseLevel(previous)
+	if !ok {
+		logLevelTransition(signal, "already_most_verbose", previous, previous)
+		return
+	}
+
+	globalLevel.SetLevel(next)
+	logLevelTransition(signal, "step_down", previous, next)
+	t.scheduleRevert()
+}
+
+// restore restaura o nível configurado do logger global
+func (t *levelToggle) restore(signal, reason string) {
+	t.stopTimer()
+
+	previous := globalLevel.Level()
+	configured := DefaultLogLevel
+	if IsInitialized() {
+		configured = GetConfig().LogLevel
+	}
+
+	// Registrar antes da alteração para que a transição seja visível mesmo
+	// quando o nível configurado for menos verboso que WARN
+	logLevelTransition(signal, reason, previous, configured)
+	globalLevel.SetLevel(configured)
+}
+
+// scheduleRevert agenda a restauração automática, se habilitada
+func (t *levelToggle) scheduleRevert() {
+	if t.opts.AutoRevertAfter <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.timer = time.AfterFunc(t.opts.AutoRevertAfter, func() {
+		select {
+		case t.revert <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// stopTimer cancela a restauração automática pendente
+func (t *levelToggle) stopTimer() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.timer != nil {
+		t.timer.Stop()
+		t.timer = nil
+	}
+}
+
+// logLevelTransition registra uma transição de nível no logger global
+func logLevelTransition(signal, reason string, previous, current core.Level) {
+	event := GetLogger().Warn(context.Background()).
+		Str("event", "log_level_changed")
+	if signal != "" {
+		event = event.Str("signal", signal)
+	}
+	event.Str("reason", reason).
+		Str("previous_level", previous.String()).
+		Str("new_level", current.String()).
+		Msg("Log level changed by signal")
+}
@@ -0,0 +1,9 @@
+//go:build !unix
+
+package logger
+
+// EnableSignalLevelToggleWithOptions não tem efeito em plataformas sem
+// SIGUSR1/SIGUSR2. Retorna uma função vazia.
+func EnableSignalLevelToggleWithOptions(opts SignalToggleOptions) func() {
+	return func() {}
+}
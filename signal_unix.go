@@ -0,0 +1,48 @@
+//go:build unix
+
+package logger
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// EnableSignalLevelToggleWithOptions habilita o controle de verbosidade do
+// logger global por sinais com as opções especificadas.
+// Retorna uma função que desabilita o controle e cancela a restauração pendente.
+func EnableSignalLevelToggleWithOptions(opts SignalToggleOptions) func() {
+	toggle := newLevelToggle(opts)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGUSR1, syscall.SIGUSR2)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case sig := <-signals:
+				switch sig {
+				case syscall.SIGUSR1:
+					toggle.stepDown("SIGUSR1")
+				case syscall.SIGUSR2:
+					toggle.restore("SIGUSR2", "restore")
+				}
+			case <-toggle.revert:
+				toggle.restore("", "auto_revert")
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			toggle.stopTimer()
+			close(done)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+//go:build unix
+
+package logger
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/victorximenis/logger/core"
+)
+
+// waitForLevel aguarda até que o nível global atinja o valor esperado
+func waitForLevel(t *testing.T, expected core.Level) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if GetLevel() == expected {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Expected level %s, got %s", expected, GetLevel())
+}
+
+func TestEnableSignalLevelToggle(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	config := NewConfig()
+	config.LogLevel = core.WARN
+	if err := Init(config); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	stop := EnableSignalLevelToggle()
+	defer stop()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Failed to send SIGUSR1: %v", err)
+	}
+	waitForLevel(t, core.INFO)
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Failed to send SIGUSR1: %v", err)
+	}
+	waitForLevel(t, core.DEBUG)
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR2); err != nil {
+		t.Fatalf("Failed to send SIGUSR2: %v", err)
+	}
+	waitForLevel(t, core.WARN)
+
+	if GetConfig().LogLevel != core.WARN {
+		t.Errorf("Expected configured level to remain WARN, got %s", GetConfig().LogLevel)
+	}
+}
+
+func TestEnableSignalLevelToggle_AutoRevert(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	if err := Init(NewConfig()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	stop := EnableSignalLevelToggleWithOptions(DefaultSignalToggleOptions().WithAutoRevertAfter(50 * time.Millisecond))
+	defer stop()
+
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGUSR1); err != nil {
+		t.Fatalf("Failed to send SIGUSR1: %v", err)
+	}
+	waitForLevel(t, core.DEBUG)
+	waitForLevel(t, core.INFO)
+}
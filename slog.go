@@ -0,0 +1,14 @@
+package logger
+
+import "github.com/victorximenis/logger/adapters"
+
+// NewGlobalSlogHandler cria um slog.Handler que escreve no adapter do
+// logger global, resolvido a cada registro. O handler continua válido após
+// uma nova chamada a Init, que encerra o pipeline anterior.
+//
+// Exemplo:
+//
+//	slog.SetDefault(slog.New(logger.NewGlobalSlogHandler()))
+func NewGlobalSlogHandler() *adapters.SlogHandler {
+	return adapters.NewSlogHandlerFunc(GetAdapter)
+}
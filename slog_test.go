@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewGlobalSlogHandler(t *testing.T) {
+	resetGlobalState()
+	defer resetGlobalState()
+
+	dir := t.TempDir()
+	newFileConfig := func(name string) Config {
+		config := NewConfig()
+		config.Output = OutputFile
+		config.LogFilePath = filepath.Join(dir, name)
+		config.Observability.Enabled = false
+		return config
+	}
+
+	if err := Init(newFileConfig("first.log")); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	log := slog.New(NewGlobalSlogHandler()).With("lib", "pgx")
+	log.Info("before reinit")
+
+	// O handler deve seguir o pipeline criado pela nova chamada a Init
+	if err := Init(newFileConfig("second.log")); err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	log.Info("after reinit")
+
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+
+	for name, msg := range map[string]string{"first.log": "before reinit", "second.log": "after reinit"} {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+		if !strings.Contains(string(content), msg) || !strings.Contains(string(content), `"lib":"pgx"`) {
+			t.Errorf("Expected %s to contain %q with the handler attributes, got %q", name, msg, content)
+		}
+	}
+}